@@ -0,0 +1,41 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/whtlnv/blackjack-model-trainer/pkg/blackjack"
+)
+
+// Exits like diff(1): 0 when the strategies are the same, 1 when they differ, 2 on trouble
+func diffCommand(args []string, stdout io.Writer, stderr io.Writer) int {
+	flags := flag.NewFlagSet("diff", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+
+	if err := flags.Parse(args); err != nil || flags.NArg() != 2 {
+		fmt.Fprintln(stderr, "usage: strategy diff <left.strategy> <right.strategy>")
+		return exitUsage
+	}
+
+	left, err := blackjack.LoadStrategyFile(flags.Arg(0))
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitUsage
+	}
+
+	right, err := blackjack.LoadStrategyFile(flags.Arg(1))
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitUsage
+	}
+
+	diff := blackjack.DiffStrategies(left, right)
+	fmt.Fprint(stdout, diff.String())
+
+	if diff.IsEmpty() {
+		return exitOk
+	}
+
+	return exitError
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffCommand(t *testing.T) {
+	t.Run("Should exit with 0 for the same strategy", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+
+		code := run([]string{"diff", idealStrategyPath, idealStrategyPath}, &stdout, &stderr)
+
+		assert.Equal(t, exitOk, code)
+		assert.Contains(t, stdout.String(), "basic strategy agreement: 100.0%")
+	})
+
+	t.Run("Should exit with 1 and print the grid for different strategies", func(t *testing.T) {
+		content, _ := os.ReadFile(idealStrategyPath)
+		changed := strings.Replace(string(content), "SSSSSHHHHH", "SSSSSHHHHS", 1)
+		path := filepath.Join(t.TempDir(), "changed.strategy")
+		os.WriteFile(path, []byte(changed), 0644)
+		var stdout, stderr bytes.Buffer
+
+		code := run([]string{"diff", idealStrategyPath, path}, &stdout, &stderr)
+
+		assert.Equal(t, exitError, code)
+		assert.Contains(t, stdout.String(), "hard: 1 of 160 cells differ")
+	})
+
+	t.Run("Should exit with 2 when a strategy does not load", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+
+		code := run([]string{"diff", idealStrategyPath, "missing.strategy"}, &stdout, &stderr)
+
+		assert.Equal(t, exitUsage, code)
+	})
+
+	t.Run("Should exit with 2 without two strategies", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+
+		code := run([]string{"diff", idealStrategyPath}, &stdout, &stderr)
+
+		assert.Equal(t, exitUsage, code)
+	})
+}
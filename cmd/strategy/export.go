@@ -0,0 +1,76 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/whtlnv/blackjack-model-trainer/pkg/blackjack"
+)
+
+var exporters = map[string]func(strategy *blackjack.Strategy, name string) ([]byte, error){
+	"json": func(strategy *blackjack.Strategy, _ string) ([]byte, error) {
+		return blackjack.ExportStrategyJSON(strategy)
+	},
+	"csv": func(strategy *blackjack.Strategy, _ string) ([]byte, error) {
+		return blackjack.ExportStrategyCSV(strategy)
+	},
+	"html": func(strategy *blackjack.Strategy, name string) ([]byte, error) {
+		return blackjack.ExportStrategyHTML(strategy, name), nil
+	},
+}
+
+// Exits with 0 when every export was written, 2 on trouble, like diff and lint
+func exportCommand(args []string, stdout io.Writer, stderr io.Writer) int {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	formats := flags.String("format", "json,csv,html", "comma separated formats to write: json, csv, html")
+	outputDir := flags.String("out", "", "directory to write to, next to each strategy file by default")
+
+	if err := flags.Parse(args); err != nil || flags.NArg() == 0 {
+		fmt.Fprintln(stderr, "usage: strategy export [-format json,csv,html] [-out dir] <file.strategy>...")
+		return exitUsage
+	}
+
+	for _, format := range strings.Split(*formats, ",") {
+		if _, ok := exporters[format]; !ok {
+			fmt.Fprintf(stderr, "unknown format %q\n", format)
+			return exitUsage
+		}
+	}
+
+	for _, path := range flags.Args() {
+		strategy, err := blackjack.LoadStrategyFile(path)
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return exitUsage
+		}
+
+		name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+		dir := *outputDir
+		if dir == "" {
+			dir = filepath.Dir(path)
+		}
+
+		for _, format := range strings.Split(*formats, ",") {
+			content, err := exporters[format](strategy, name)
+			if err != nil {
+				fmt.Fprintln(stderr, err)
+				return exitUsage
+			}
+
+			exportPath := filepath.Join(dir, name+"."+format)
+			if err := os.WriteFile(exportPath, content, 0644); err != nil {
+				fmt.Fprintln(stderr, err)
+				return exitUsage
+			}
+
+			fmt.Fprintln(stdout, exportPath)
+		}
+	}
+
+	return exitOk
+}
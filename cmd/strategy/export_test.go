@@ -0,0 +1,61 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Helpers
+
+const idealStrategyPath = "../../pkg/blackjack/__mock_data__/strategies/ideal.strategy"
+
+// Tests
+
+func TestExportCommand(t *testing.T) {
+	t.Run("Should write every format by default", func(t *testing.T) {
+		dir := t.TempDir()
+		var stdout, stderr bytes.Buffer
+
+		code := run([]string{"export", "-out", dir, idealStrategyPath}, &stdout, &stderr)
+
+		assert.Equal(t, exitOk, code)
+		for _, name := range []string{"ideal.json", "ideal.csv", "ideal.html"} {
+			assert.FileExists(t, filepath.Join(dir, name))
+		}
+	})
+
+	t.Run("Should only write the formats asked for", func(t *testing.T) {
+		dir := t.TempDir()
+		var stdout, stderr bytes.Buffer
+
+		code := run([]string{"export", "-format", "json", "-out", dir, idealStrategyPath}, &stdout, &stderr)
+
+		assert.Equal(t, exitOk, code)
+		entries, _ := os.ReadDir(dir)
+		assert.Len(t, entries, 1)
+		assert.Equal(t, filepath.Join(dir, "ideal.json")+"\n", stdout.String())
+	})
+
+	t.Run("Should fail on an unknown format", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+
+		code := run([]string{"export", "-format", "pdf", idealStrategyPath}, &stdout, &stderr)
+
+		assert.Equal(t, exitUsage, code)
+	})
+
+	t.Run("Should fail on a strategy that does not load", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "broken.strategy")
+		os.WriteFile(path, []byte("[hard]\nX\n"), 0644)
+		var stdout, stderr bytes.Buffer
+
+		code := run([]string{"export", path}, &stdout, &stderr)
+
+		assert.Equal(t, exitUsage, code)
+		assert.Contains(t, stderr.String(), "line 2, column 1")
+	})
+}
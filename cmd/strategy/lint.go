@@ -0,0 +1,48 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/whtlnv/blackjack-model-trainer/pkg/blackjack"
+)
+
+// Exits with 0 when every strategy is clean, 1 when anything was flagged, 2 on trouble
+func lintCommand(args []string, stdout io.Writer, stderr io.Writer) int {
+	flags := flag.NewFlagSet("lint", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+
+	if err := flags.Parse(args); err != nil || flags.NArg() == 0 {
+		fmt.Fprintln(stderr, "usage: strategy lint <file.strategy>...")
+		return exitUsage
+	}
+
+	flagged := 0
+	for _, path := range flags.Args() {
+		// read leniently, so cells that can't be played are findings rather than load errors
+		raw, err := blackjack.LoadRawStrategyFile(path)
+		if err != nil {
+			fmt.Fprintln(stderr, err)
+			return exitUsage
+		}
+
+		findings, err := blackjack.LintStrategy(raw)
+		if err != nil {
+			fmt.Fprintf(stderr, "%s: %s\n", path, err)
+			return exitUsage
+		}
+
+		for _, finding := range findings {
+			fmt.Fprintf(stdout, "%s: %s\n", path, finding)
+		}
+		flagged += len(findings)
+	}
+
+	if flagged == 0 {
+		return exitOk
+	}
+
+	fmt.Fprintf(stdout, "%d findings\n", flagged)
+	return exitError
+}
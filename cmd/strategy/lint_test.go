@@ -0,0 +1,65 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLintCommand(t *testing.T) {
+	t.Run("Should exit with 0 for a clean strategy", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+
+		code := run([]string{"lint", idealStrategyPath}, &stdout, &stderr)
+
+		assert.Equal(t, exitOk, code)
+		assert.Empty(t, stdout.String())
+	})
+
+	t.Run("Should exit with 1 and print every finding", func(t *testing.T) {
+		content, _ := os.ReadFile(idealStrategyPath)
+		changed := strings.Replace(string(content), "SSSSSSSSSS", "HSSSSSSSSS", 1)
+		path := filepath.Join(t.TempDir(), "changed.strategy")
+		os.WriteFile(path, []byte(changed), 0644)
+		var stdout, stderr bytes.Buffer
+
+		code := run([]string{"lint", idealStrategyPath, path}, &stdout, &stderr)
+
+		assert.Equal(t, exitError, code)
+		assert.Contains(t, stdout.String(), path+": warning: [hard] 17 vs 2: hitting hard 17 (never-sensible)\n")
+		assert.NotContains(t, stdout.String(), idealStrategyPath+":")
+	})
+
+	t.Run("Should report cells that can't be played instead of failing to load", func(t *testing.T) {
+		content, _ := os.ReadFile(idealStrategyPath)
+		changed := strings.Replace(string(content), "SSSSSSSSSS", "PSSSSSSSSS", 1)
+		path := filepath.Join(t.TempDir(), "impossible.strategy")
+		os.WriteFile(path, []byte(changed), 0644)
+		var stdout, stderr bytes.Buffer
+
+		code := run([]string{"lint", path}, &stdout, &stderr)
+
+		assert.Equal(t, exitError, code)
+		assert.Contains(t, stdout.String(), path+": error: [hard] 17 vs 2: only pairs can be split (impossible-action)\n")
+	})
+
+	t.Run("Should exit with 2 when a strategy does not load", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+
+		code := run([]string{"lint", "missing.strategy"}, &stdout, &stderr)
+
+		assert.Equal(t, exitUsage, code)
+	})
+
+	t.Run("Should exit with 2 without a strategy", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+
+		code := run([]string{"lint"}, &stdout, &stderr)
+
+		assert.Equal(t, exitUsage, code)
+	})
+}
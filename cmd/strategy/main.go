@@ -0,0 +1,56 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+)
+
+const (
+	exitOk    = 0
+	exitError = 1
+	exitUsage = 2
+)
+
+type command func(args []string, stdout io.Writer, stderr io.Writer) int
+
+var commands = map[string]command{
+	"diff":   diffCommand,
+	"export": exportCommand,
+	"lint":   lintCommand,
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout io.Writer, stderr io.Writer) int {
+	if len(args) == 0 {
+		printUsage(stderr)
+		return exitUsage
+	}
+
+	command, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(stderr, "unknown command %q\n", args[0])
+		printUsage(stderr)
+		return exitUsage
+	}
+
+	return command(args[1:], stdout, stderr)
+}
+
+func printUsage(stderr io.Writer) {
+	names := []string{}
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Fprintln(stderr, "usage: strategy <command> [options] <file.strategy>...")
+	fmt.Fprintln(stderr, "commands:")
+	for _, name := range names {
+		fmt.Fprintln(stderr, "  "+name)
+	}
+}
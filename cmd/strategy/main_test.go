@@ -0,0 +1,27 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	t.Run("Should fail on a missing command", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+
+		code := run([]string{}, &stdout, &stderr)
+
+		assert.Equal(t, exitUsage, code)
+		assert.Contains(t, stderr.String(), "usage:")
+	})
+
+	t.Run("Should fail on an unknown command", func(t *testing.T) {
+		var stdout, stderr bytes.Buffer
+
+		code := run([]string{"frobnicate"}, &stdout, &stderr)
+
+		assert.Equal(t, exitUsage, code)
+	})
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"github.com/whtlnv/blackjack-model-trainer/pkg/blackjack"
+	"github.com/whtlnv/blackjack-model-trainer/pkg/genetics"
+)
+
+var crossoverChoices = []named[func() genetics.Crossoverish]{
+	{"uniform", func() genetics.Crossoverish { return genetics.UniformCrossover{} }},
+	{"biased", func() genetics.Crossoverish { return genetics.UniformCrossover{Bias: 0.7} }},
+	{"one-point", func() genetics.Crossoverish { return genetics.OnePointCrossover{} }},
+	{"two-point", func() genetics.Crossoverish { return genetics.TwoPointCrossover{} }},
+	{"rows", func() genetics.Crossoverish {
+		return genetics.BlockCrossover{Blocks: geneBlocks(blackjack.RowBlocks())}
+	}},
+	{"sections", func() genetics.Crossoverish {
+		return genetics.BlockCrossover{Blocks: geneBlocks(blackjack.SectionBlocks())}
+	}},
+}
+
+func geneBlocks(blocks []blackjack.GeneBlock) []genetics.Block {
+	converted := []genetics.Block{}
+	for _, block := range blocks {
+		converted = append(converted, genetics.Block{Group: block.Group, Start: block.Start, Length: block.Length, Total: block.Total})
+	}
+
+	return converted
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/whtlnv/blackjack-model-trainer/internal/randomizer"
+	"github.com/whtlnv/blackjack-model-trainer/pkg/blackjack"
+)
+
+func TestCrossoverChoices(t *testing.T) {
+	length := len(blackjack.GetSequencing())
+	mother := bytes.Repeat([]byte{'M'}, length)
+	father := bytes.Repeat([]byte{'F'}, length)
+
+	cross := func(name string) []byte {
+		newCrossover, err := byName("crossover", crossoverChoices, name)
+		assert.NoError(t, err)
+
+		return newCrossover().Cross(mother, father, randomizer.NewRandomizer(1))
+	}
+
+	t.Run("Should mix both parents evenly", func(t *testing.T) {
+		child := cross("uniform")
+
+		assert.InDelta(t, 0.5, float64(bytes.Count(child, []byte{'M'}))/float64(length), 0.1)
+	})
+
+	t.Run("Should take most genes from the fitter parent", func(t *testing.T) {
+		child := cross("biased")
+
+		assert.InDelta(t, 0.7, float64(bytes.Count(child, []byte{'M'}))/float64(length), 0.1)
+	})
+
+	t.Run("Should take the head of the mother and the tail of the father", func(t *testing.T) {
+		child := cross("one-point")
+
+		assert.Equal(t, byte('M'), child[0])
+		assert.Equal(t, byte('F'), child[length-1])
+		assert.Equal(t, 1, parentChanges(child))
+	})
+
+	t.Run("Should take a single run of genes from the father", func(t *testing.T) {
+		child := cross("two-point")
+
+		assert.Equal(t, byte('M'), child[0])
+		assert.Equal(t, byte('M'), child[length-1])
+		assert.LessOrEqual(t, parentChanges(child), 2)
+	})
+
+	blockCases := []struct {
+		desc   string
+		name   string
+		blocks []blackjack.GeneBlock
+	}{
+		{desc: "Should keep every row of the charts whole", name: "rows", blocks: blackjack.RowBlocks()},
+		{desc: "Should keep every section whole", name: "sections", blocks: blackjack.SectionBlocks()},
+	}
+
+	for _, testCase := range blockCases {
+		t.Run(testCase.desc, func(t *testing.T) {
+			child := cross(testCase.name)
+
+			for _, block := range testCase.blocks {
+				assert.Equal(t, 0, parentChanges(child[block.Start:block.Start+block.Length]), block.Label)
+			}
+			assert.Contains(t, string(child), "M")
+			assert.Contains(t, string(child), "F")
+		})
+	}
+}
+
+// Helper methods
+
+// How many times the genes switch from one parent to the other
+func parentChanges(child []byte) int {
+	changes := 0
+	for i := 1; i < len(child); i++ {
+		if child[i] != child[i-1] {
+			changes++
+		}
+	}
+
+	return changes
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/samber/lo"
+
+	"github.com/whtlnv/blackjack-model-trainer/pkg/blackjack"
+	"github.com/whtlnv/blackjack-model-trainer/pkg/genetics"
+)
+
+// Where a player falls along one behaviour, from 0 to 1
+type descriptor struct {
+	describe func(statistics blackjack.PlayerStatistics, strategy blackjack.Strategyish) float64
+	// Cells of the archive along the behaviour, the session's when unset
+	bins int
+}
+
+var descriptorChoices = []named[descriptor]{
+	{"doubles", descriptor{describe: func(statistics blackjack.PlayerStatistics, _ blackjack.Strategyish) float64 {
+		return perHandPlayed(statistics, statistics.Doubles)
+	}}},
+	{"splits", descriptor{describe: func(statistics blackjack.PlayerStatistics, _ blackjack.Strategyish) float64 {
+		return perHandPlayed(statistics, statistics.Splits)
+	}}},
+	// a cell per value the spread can take
+	{"spot-spread", descriptor{describe: func(_ blackjack.PlayerStatistics, strategy blackjack.Strategyish) float64 {
+		return blackjack.SpotSpread(strategy)
+	}, bins: blackjack.SpotSpreadLevels}},
+	{"hands-played", descriptor{describe: func(statistics blackjack.PlayerStatistics, _ blackjack.Strategyish) float64 {
+		if statistics.GamesSeen == 0 {
+			return 0
+		}
+		return float64(statistics.GamesPlayed) / float64(statistics.GamesSeen)
+	}}},
+}
+
+// The cells of the archive along each descriptor
+func descriptorBins(descriptors []descriptor, bins int) []int {
+	return lo.Map(descriptors, func(descriptor descriptor, _ int) int {
+		return lo.Ternary(descriptor.bins > 0, descriptor.bins, bins)
+	})
+}
+
+// Players sit at the table in the order of their fitted candidates
+func candidateEntries(players []blackjack.Playerish, fittedPlayers []*genetics.Candidate, descriptors []descriptor) []*genetics.ArchiveEntry {
+	return lo.Map(players, func(player blackjack.Playerish, i int) *genetics.ArchiveEntry {
+		statistics := player.GetStatistics()
+		strategy, err := blackjack.NewStrategy(statistics.Strategy)
+		if err != nil {
+			panic(err)
+		}
+
+		return &genetics.ArchiveEntry{
+			Chromosome: fittedPlayers[i].Chromosome,
+			Fitness:    fittedPlayers[i].Fitness,
+			Descriptor: lo.Map(descriptors, func(descriptor descriptor, _ int) float64 { return descriptor.describe(statistics, strategy) }),
+		}
+	})
+}
+
+// Replaces the directory with a strategy file per filled cell, and an archive.csv listing their cells, descriptors and fitness
+func saveArchive(archive *genetics.Archive, dir string) error {
+	err := os.RemoveAll(dir)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(dir, 0755)
+	if err != nil {
+		return err
+	}
+
+	rows := [][]string{{"file", "cell", "descriptor", "fitness"}}
+	for _, entry := range archive.Elites() {
+		cell := lo.Map(archive.Cell(entry.Descriptor), func(index int, _ int) string { return strconv.Itoa(index) })
+		file := fmt.Sprintf("cell-%s.strategy", strings.Join(cell, "-"))
+
+		strategy, err := blackjack.NewStrategy(entry.Chromosome.Raw())
+		if err != nil {
+			return err
+		}
+
+		err = blackjack.SaveStrategyFile(filepath.Join(dir, file), strategy)
+		if err != nil {
+			return err
+		}
+
+		descriptor := lo.Map(entry.Descriptor, func(value float64, _ int) string { return strconv.FormatFloat(value, 'f', 4, 64) })
+		rows = append(rows, []string{file, strings.Join(cell, " "), strings.Join(descriptor, " "), strconv.FormatFloat(entry.Fitness, 'f', -1, 64)})
+	}
+
+	index, err := os.Create(filepath.Join(dir, "archive.csv"))
+	if err != nil {
+		return err
+	}
+	defer index.Close()
+
+	return csv.NewWriter(index).WriteAll(rows)
+}
+
+// Helper methods
+
+func perHandPlayed(statistics blackjack.PlayerStatistics, count int) float64 {
+	if statistics.GamesPlayed == 0 {
+		return 0
+	}
+
+	return float64(count) / float64(statistics.GamesPlayed)
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"encoding/csv"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/whtlnv/blackjack-model-trainer/pkg/blackjack"
+	"github.com/whtlnv/blackjack-model-trainer/pkg/genetics"
+)
+
+func TestDescriptors(t *testing.T) {
+	ideal, err := blackjack.LoadStrategyFile(idealStrategyPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	statistics := blackjack.PlayerStatistics{GamesSeen: 50, GamesPlayed: 40, Doubles: 4, Splits: 2}
+
+	t.Run("Should describe how often a player doubles, splits and plays, and its spot spread", func(t *testing.T) {
+		descriptors, err := listByName("descriptor", descriptorChoices, "doubles, splits,hands-played,spot-spread")
+		assert.NoError(t, err)
+
+		got := []float64{}
+		for _, descriptor := range descriptors {
+			got = append(got, descriptor.describe(statistics, ideal))
+		}
+
+		assert.Equal(t, []float64{0.1, 0.05, 0.8, blackjack.SpotSpread(ideal)}, got)
+	})
+
+	t.Run("Should describe a player who never played at 0", func(t *testing.T) {
+		descriptors, _ := listByName("descriptor", descriptorChoices, "doubles,hands-played")
+
+		for _, descriptor := range descriptors {
+			assert.Equal(t, 0.0, descriptor.describe(blackjack.PlayerStatistics{}, ideal))
+		}
+	})
+
+	t.Run("Should give the spot spread a cell per value it can take", func(t *testing.T) {
+		descriptors, _ := listByName("descriptor", descriptorChoices, "doubles,spot-spread")
+
+		assert.Equal(t, []int{10, blackjack.SpotSpreadLevels}, descriptorBins(descriptors, 10))
+	})
+
+	t.Run("Should reach every spot spread cell", func(t *testing.T) {
+		archive := genetics.NewArchive([]int{blackjack.SpotSpreadLevels})
+
+		cells := lo.Map([]float64{0, 0.5, 1}, func(spread float64, _ int) int { return archive.Cell([]float64{spread})[0] })
+
+		assert.Equal(t, []int{0, 1, 2}, cells)
+	})
+}
+
+func TestSaveArchive(t *testing.T) {
+	ideal, err := blackjack.LoadStrategyFile(idealStrategyPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chromosome := genetics.NewChromosome(ideal.GetEncodedStrategy(), blackjack.GetSequencing())
+
+	archive := genetics.NewArchive([]int{10, 10})
+	archive.Add(&genetics.ArchiveEntry{Chromosome: chromosome, Fitness: 12.5, Descriptor: []float64{0.15, 0.9}})
+	archive.Add(&genetics.ArchiveEntry{Chromosome: chromosome, Fitness: 3, Descriptor: []float64{0.5, 0}})
+
+	t.Run("Should save a strategy file per filled cell, and an index of them", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "map-elites")
+
+		assert.NoError(t, saveArchive(archive, dir))
+
+		saved, err := blackjack.LoadStrategyFile(filepath.Join(dir, "cell-1-9.strategy"))
+		assert.NoError(t, err)
+		assert.Equal(t, ideal.GetEncodedStrategy(), saved.GetEncodedStrategy())
+
+		index, _ := os.Open(filepath.Join(dir, "archive.csv"))
+		defer index.Close()
+		rows, err := csv.NewReader(index).ReadAll()
+
+		assert.NoError(t, err)
+		assert.Equal(t, [][]string{
+			{"file", "cell", "descriptor", "fitness"},
+			{"cell-1-9.strategy", "1 9", "0.1500 0.9000", "12.5"},
+			{"cell-5-0.strategy", "5 0", "0.5000 0.0000", "3"},
+		}, rows)
+	})
+}
@@ -31,8 +31,11 @@ func BlackjackFitnessFunction(statistics blackjack.PlayerStatistics) float64 {
 		fitness += float64(statistics.GamesPlayed)
 	}
 
-	winrate := float64(statistics.GamesWon) / float64(statistics.GamesPlayed)
-	fitness += winrate * 100
+	// a player who never played has no win rate
+	if statistics.GamesPlayed > 0 {
+		winrate := float64(statistics.GamesWon) / float64(statistics.GamesPlayed)
+		fitness += winrate * 100
+	}
 
 	return fitness
 }
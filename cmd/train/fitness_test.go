@@ -1,6 +1,7 @@
 package main
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -128,4 +129,18 @@ func TestBlackjackFitnessFunction(t *testing.T) {
 
 		assert.Greater(t, fitnessB, fitnessA)
 	})
+
+	t.Run("A player who never played should get a finite fitness", func(t *testing.T) {
+		player := blackjack.PlayerStatistics{
+			GamesSeen:       100,
+			GamesPlayed:     0,
+			InitialBankroll: 100,
+			Bankroll:        100,
+		}
+
+		fitness := BlackjackFitnessFunction(player)
+
+		assert.False(t, math.IsNaN(fitness))
+		assert.Equal(t, 100.0, fitness)
+	})
 }
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/whtlnv/blackjack-model-trainer/pkg/blackjack"
+	"github.com/whtlnv/blackjack-model-trainer/pkg/genetics"
+)
+
+// Sections held at the values of a strategy file, written as section[,section...]=file.strategy
+type freezeOption struct {
+	Sections []string
+	Path     string
+}
+
+type freezeFlag struct {
+	options *[]freezeOption
+}
+
+func (flag freezeFlag) String() string {
+	if flag.options == nil {
+		return ""
+	}
+
+	values := []string{}
+	for _, option := range *flag.options {
+		values = append(values, strings.Join(option.Sections, ",")+"="+option.Path)
+	}
+
+	return strings.Join(values, " ")
+}
+
+func (flag freezeFlag) Set(value string) error {
+	option, err := parseFreezeOption(value)
+	if err != nil {
+		return err
+	}
+
+	*flag.options = append(*flag.options, option)
+	return nil
+}
+
+func parseFreezeOption(value string) (freezeOption, error) {
+	sections, path, found := strings.Cut(value, "=")
+	if !found || sections == "" || path == "" {
+		return freezeOption{}, fmt.Errorf("expected section[,section...]=file.strategy, got %q", value)
+	}
+
+	return freezeOption{strings.Split(sections, ","), path}, nil
+}
+
+// One mask for every frozen section, later options overriding earlier ones; nil when nothing is frozen
+func freezeMask(options []freezeOption) (genetics.FreezeMask, error) {
+	if len(options) == 0 {
+		return nil, nil
+	}
+
+	mask := make(genetics.FreezeMask, len(blackjack.GetSequencing()))
+	for _, option := range options {
+		strategy, err := blackjack.LoadStrategyFile(option.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		sections, err := blackjack.FreezeSections(strategy, option.Sections)
+		if err != nil {
+			return nil, err
+		}
+
+		for gene, value := range sections {
+			if value != genetics.Unfrozen {
+				mask[gene] = value
+			}
+		}
+	}
+
+	return mask, nil
+}
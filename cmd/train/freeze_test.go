@@ -0,0 +1,57 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/whtlnv/blackjack-model-trainer/pkg/blackjack"
+	"github.com/whtlnv/blackjack-model-trainer/pkg/genetics"
+)
+
+const idealStrategyPath = "../../pkg/blackjack/__mock_data__/strategies/ideal.strategy"
+
+func TestFreezeOptions(t *testing.T) {
+	t.Run("Should parse sections and file", func(t *testing.T) {
+		options := []freezeOption{}
+		flag := freezeFlag{&options}
+
+		assert.NoError(t, flag.Set("hard,soft,pair=ideal.strategy"))
+		assert.NoError(t, flag.Set("bet=ramp.strategy"))
+
+		assert.Equal(t, []freezeOption{
+			{[]string{"hard", "soft", "pair"}, "ideal.strategy"},
+			{[]string{"bet"}, "ramp.strategy"},
+		}, options)
+		assert.Equal(t, "hard,soft,pair=ideal.strategy bet=ramp.strategy", flag.String())
+	})
+
+	t.Run("Should reject a value without a file", func(t *testing.T) {
+		options := []freezeOption{}
+
+		assert.Error(t, freezeFlag{&options}.Set("hard"))
+	})
+
+	t.Run("Should freeze nothing without options", func(t *testing.T) {
+		mask, err := freezeMask(nil)
+
+		assert.NoError(t, err)
+		assert.Nil(t, mask)
+	})
+
+	t.Run("Should build a mask from the strategy file", func(t *testing.T) {
+		mask, err := freezeMask([]freezeOption{{[]string{"hard"}, idealStrategyPath}})
+		assert.NoError(t, err)
+
+		ideal, _ := blackjack.LoadStrategyFile(idealStrategyPath)
+		want, _ := blackjack.FreezeSections(ideal, []string{"hard"})
+
+		assert.Equal(t, genetics.FreezeMask(want), mask)
+	})
+
+	t.Run("Should fail on a missing file", func(t *testing.T) {
+		_, err := freezeMask([]freezeOption{{[]string{"hard"}, "missing.strategy"}})
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/samber/lo"
+
+	"github.com/whtlnv/blackjack-model-trainer/pkg/genetics"
+)
+
+// The options of one island over the session's, as name=value[,name=value...] with the names of the session flags
+func islandFlagSet(options *trainingOptions) *flag.FlagSet {
+	flags := flag.NewFlagSet("island", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+
+	flags.StringVar(&options.Selection, "selection", options.Selection, "")
+	flags.Float64Var(&options.CloneRate, "clone-rate", options.CloneRate, "")
+	flags.StringVar(&options.Crossover, "crossover", options.Crossover, "")
+	flags.Float64Var(&options.SwapRate, "swap-rate", options.SwapRate, "")
+	flags.Float64Var(&options.RowCopyRate, "row-copy-rate", options.RowCopyRate, "")
+	flags.Float64Var(&options.CreepRate, "creep-rate", options.CreepRate, "")
+	flags.Float64Var(&options.BlockMutation, "block-mutation-rate", options.BlockMutation, "")
+	flags.IntVar(&options.Elitism, "elitism", options.Elitism, "")
+	flags.StringVar(&options.Scaling, "scaling", options.Scaling, "")
+	flags.StringVar(&options.MutationSchedule, "mutation-schedule", options.MutationSchedule, "")
+	flags.Float64Var(&options.DiversityThreshold, "diversity-threshold", options.DiversityThreshold, "")
+	flags.Float64Var(&options.SharingRadius, "sharing-radius", options.SharingRadius, "")
+
+	return flags
+}
+
+// The session options with the overrides of an island
+func islandTrainingOptions(sessionOptions trainingOptions, overrides string) (trainingOptions, error) {
+	island := sessionOptions
+	if strings.TrimSpace(overrides) == "" {
+		return island, nil
+	}
+
+	args := lo.Map(strings.Split(overrides, ","), func(override string, _ int) string { return "-" + strings.TrimSpace(override) })
+	err := islandFlagSet(&island).Parse(args)
+	if err != nil {
+		return island, fmt.Errorf("invalid island options %q: %w", overrides, err)
+	}
+
+	return island, nil
+}
+
+// The generation options of every island, the session's for the islands given no overrides
+func islandGenerationOptions(sessionOptions trainingOptions, options genetics.GenerationOptions) ([]genetics.GenerationOptions, error) {
+	count := lo.Max([]int{sessionOptions.Islands, 1})
+	if len(sessionOptions.IslandOptions) > count {
+		return nil, fmt.Errorf("options given for %d islands, but there are only %d", len(sessionOptions.IslandOptions), count)
+	}
+
+	islands := []genetics.GenerationOptions{}
+	for i := 0; i < count; i++ {
+		if i >= len(sessionOptions.IslandOptions) {
+			islands = append(islands, options)
+			continue
+		}
+
+		island, err := islandTrainingOptions(sessionOptions, sessionOptions.IslandOptions[i])
+		if err != nil {
+			return nil, err
+		}
+
+		islandOptions, err := generationOptions(island)
+		if err != nil {
+			return nil, err
+		}
+
+		islands = append(islands, islandOptions)
+	}
+
+	return islands, nil
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/whtlnv/blackjack-model-trainer/pkg/genetics"
+)
+
+func TestIslandOptions(t *testing.T) {
+	sessionOptions := defaultTrainingOptions()
+	sessionOptions.Islands = 3
+	options, err := generationOptions(sessionOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("Should override the session options of an island", func(t *testing.T) {
+		got, err := islandTrainingOptions(sessionOptions, "selection=tournament, elitism=5")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "tournament", got.Selection)
+		assert.Equal(t, 5, got.Elitism)
+		assert.Equal(t, sessionOptions.Crossover, got.Crossover)
+	})
+
+	t.Run("Should give every island its own options", func(t *testing.T) {
+		islandSession := sessionOptions
+		islandSession.IslandOptions = []string{"selection=tournament,elitism=0", "crossover=two-point"}
+
+		got, err := islandGenerationOptions(islandSession, options)
+
+		assert.NoError(t, err)
+		assert.Len(t, got, 3)
+		assert.IsType(t, genetics.TournamentSelector{}, got[0].Selector)
+		assert.Equal(t, 0, got[0].Elitism)
+		assert.Equal(t, genetics.TwoPointCrossover{}, got[1].Crossover)
+		assert.Equal(t, options.Elitism, got[1].Elitism)
+		assert.Equal(t, options.Crossover, got[2].Crossover)
+	})
+
+	t.Run("Should fail on an unknown option", func(t *testing.T) {
+		_, err := islandTrainingOptions(sessionOptions, "population=10")
+
+		assert.Error(t, err)
+	})
+
+	t.Run("Should fail on an unknown value", func(t *testing.T) {
+		islandSession := sessionOptions
+		islandSession.IslandOptions = []string{"selection=lottery"}
+
+		_, err := islandGenerationOptions(islandSession, options)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("Should fail on options for more islands than there are", func(t *testing.T) {
+		islandSession := sessionOptions
+		islandSession.IslandOptions = []string{"", "", "", "elitism=1"}
+
+		_, err := islandGenerationOptions(islandSession, options)
+
+		assert.Error(t, err)
+	})
+}
+
+// Run with -race, islands evolve at once
+func TestIslandTrainingSession(t *testing.T) {
+	t.Run("Should evolve islands with their own options, migrating between them", func(t *testing.T) {
+		options := shortTrainingOptions(t)
+		options.Islands = 2
+		options.MigrationInterval = 1
+		options.Migrants = 1
+		options.IslandOptions = []string{"selection=tournament", "crossover=two-point,scaling=rank"}
+
+		trainingSession(options)
+
+		saved, _ := filepath.Glob(filepath.Join(options.OutputDir, "*.strategy"))
+		assert.Len(t, saved, options.Generations)
+	})
+}
@@ -1,5 +1,68 @@
 package main
 
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+)
+
 func main() {
-	trainingSession()
+	options := defaultTrainingOptions()
+	flag.StringVar(&options.OutputDir, "out", options.OutputDir, "directory to save the best strategy of each generation to")
+	flag.IntVar(&options.Generations, "generations", options.Generations, "number of generations to train")
+	flag.IntVar(&options.PopulationSize, "population", options.PopulationSize, "number of candidates in every generation")
+	flag.IntVar(&options.HandsPerGeneration, "hands", options.HandsPerGeneration, "hands every generation plays")
+	flag.StringVar(&options.ReferencePath, "reference", options.ReferencePath, "strategy file to report agreement with every generation")
+	flag.Var(freezeFlag{&options.Freeze}, "freeze", "hold sections at the values of a strategy file, as section[,section...]=file.strategy; repeatable")
+	flag.Var(stringsFlag{&options.SeedPaths}, "seed", "strategy file to seed the first generation with; repeatable")
+	flag.Float64Var(&options.SeedRate, "seed-rate", options.SeedRate, "share of the first generation made of seeds and their perturbed copies, the rest is random")
+	flag.Float64Var(&options.SeedMutationRate, "seed-mutation-rate", options.SeedMutationRate, "mutation rate of the perturbed copies of the seeds")
+	flag.StringVar(&options.Selection, "selection", options.Selection, "how parents are picked: cutoff, tournament, roulette, rank or sus")
+	flag.Float64Var(&options.CloneRate, "clone-rate", options.CloneRate, "share of each generation cloned from selected candidates, with a selection other than cutoff")
+	flag.StringVar(&options.Crossover, "crossover", options.Crossover, "how parents are combined: uniform, biased, one-point, two-point, rows or sections")
+	flag.Float64Var(&options.SwapRate, "swap-rate", options.SwapRate, "chance of swapping each gene with the next one of its chart row or section")
+	flag.Float64Var(&options.RowCopyRate, "row-copy-rate", options.RowCopyRate, "chance of each chart row being copied from the row of the hand total one above or below")
+	flag.Float64Var(&options.CreepRate, "creep-rate", options.CreepRate, "chance of each bankroll, bet and spots digit moving one up or down")
+	flag.Float64Var(&options.BlockMutation, "block-mutation-rate", options.BlockMutation, "chance of each chart row being randomized whole")
+	flag.IntVar(&options.Elitism, "elitism", options.Elitism, "number of fittest candidates carried over unchanged every generation")
+	flag.StringVar(&options.Scaling, "scaling", options.Scaling, "how fitness is scaled before selection: max, min-max, sigma, rank or boltzmann")
+	flag.StringVar(&options.MutationSchedule, "mutation-schedule", options.MutationSchedule, "how the mutation rate changes over generations: constant, linear or exponential")
+	flag.Float64Var(&options.DiversityThreshold, "diversity-threshold", options.DiversityThreshold, "triple the mutation rate while diversity, from 0 to 1, is below this; 0 to never")
+	flag.BoolVar(&options.SelfAdaptive, "self-adaptive", options.SelfAdaptive, "let every candidate carry and evolve its own mutation rate")
+	flag.Float64Var(&options.SharingRadius, "sharing-radius", options.SharingRadius, "share fitness among candidates differing on less than this share of genes, from 0 to 1; 0 to never")
+	flag.IntVar(&options.Islands, "islands", options.Islands, "number of populations evolving side by side on their own tables")
+	flag.IntVar(&options.MigrationInterval, "migration-interval", options.MigrationInterval, "generations between migrations of the fittest candidates across islands; 0 to never")
+	flag.IntVar(&options.Migrants, "migrants", options.Migrants, "number of fittest candidates each island sends on every migration")
+	flag.Var(stringsFlag{&options.IslandOptions}, "island", "options of the next island over the session's, as name=value[,name=value...] with selection, clone-rate, crossover, swap-rate, row-copy-rate, creep-rate, block-mutation-rate, elitism, scaling, mutation-schedule, diversity-threshold or sharing-radius; repeatable")
+	flag.StringVar(&options.Topology, "topology", options.Topology, "where migrants go: ring, to the next island, or full, to every other island")
+	flag.StringVar(&options.Objectives, "objectives", options.Objectives, "comma separated objectives to evolve with NSGA-II instead of one fitness: ev, ruin and sd; the Pareto front is saved every generation")
+	flag.StringVar(&options.MapElites, "map-elites", options.MapElites, "comma separated behaviours to keep the best strategy of every style of: doubles, splits, spot-spread and hands-played; the archive is saved every generation")
+	flag.IntVar(&options.MapElitesBins, "map-elites-bins", options.MapElitesBins, "cells of the MAP-Elites archive along each behaviour, spot-spread has a cell per value it can take")
+	flag.Parse()
+
+	if err := validateOptions(options); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		// like a bad flag
+		os.Exit(2)
+	}
+
+	trainingSession(options)
+}
+
+// Fails on options that can't go together
+func validateOptions(options trainingOptions) error {
+	if options.Objectives != "" && options.MapElites != "" {
+		return errors.New("-objectives and -map-elites can't be used together")
+	}
+
+	if options.MapElites != "" && options.Islands > 1 {
+		return errors.New("-map-elites runs on a single island, drop -islands")
+	}
+
+	if options.Objectives != "" && options.Islands > 1 {
+		return errors.New("-objectives runs on a single island, drop -islands")
+	}
+
+	return nil
 }
@@ -1,7 +1,66 @@
 package main
 
-import "testing"
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func shortTrainingOptions(t *testing.T) trainingOptions {
+	options := defaultTrainingOptions()
+	options.OutputDir = t.TempDir()
+	options.Generations = 3
+	options.PopulationSize = 10
+	options.HandsPerGeneration = 50
+
+	return options
+}
 
 func TestTrainingSession(t *testing.T) {
-	trainingSession()
+	t.Run("Should save the best strategy of every generation", func(t *testing.T) {
+		options := shortTrainingOptions(t)
+
+		trainingSession(options)
+
+		saved, _ := filepath.Glob(filepath.Join(options.OutputDir, "*.strategy"))
+		assert.Len(t, saved, options.Generations)
+	})
+}
+
+func TestValidateOptions(t *testing.T) {
+	testCases := []struct {
+		desc   string
+		change func(options *trainingOptions)
+		want   string
+	}{
+		{
+			desc:   "Should refuse objectives together with MAP-Elites",
+			change: func(options *trainingOptions) { options.Objectives, options.MapElites = "ev", "doubles" },
+			want:   "-objectives and -map-elites can't be used together",
+		},
+		{
+			desc:   "Should refuse MAP-Elites on several islands",
+			change: func(options *trainingOptions) { options.MapElites, options.Islands = "doubles", 2 },
+			want:   "-map-elites runs on a single island, drop -islands",
+		},
+		{
+			desc:   "Should refuse objectives on several islands",
+			change: func(options *trainingOptions) { options.Objectives, options.Islands = "ev", 2 },
+			want:   "-objectives runs on a single island, drop -islands",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.desc, func(t *testing.T) {
+			options := defaultTrainingOptions()
+			testCase.change(&options)
+
+			assert.EqualError(t, validateOptions(options), testCase.want)
+		})
+	}
+
+	t.Run("Should accept the defaults", func(t *testing.T) {
+		assert.NoError(t, validateOptions(defaultTrainingOptions()))
+	})
 }
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+// A value picked on the command line by its name
+type named[T any] struct {
+	name  string
+	value T
+}
+
+// The value going by the name, or an error listing every name
+func byName[T any](kind string, choices []named[T], name string) (T, error) {
+	choice, found := lo.Find(choices, func(choice named[T]) bool { return choice.name == name })
+	if !found {
+		return choice.value, fmt.Errorf("unknown %s %q, expected %s", kind, name, nameList(choices))
+	}
+
+	return choice.value, nil
+}
+
+// The values going by a comma separated list of names, none when empty
+func listByName[T any](kind string, choices []named[T], names string) ([]T, error) {
+	values := []T{}
+	if names == "" {
+		return values, nil
+	}
+
+	for _, name := range strings.Split(names, ",") {
+		value, err := byName(kind, choices, strings.TrimSpace(name))
+		if err != nil {
+			return nil, err
+		}
+
+		values = append(values, value)
+	}
+
+	return values, nil
+}
+
+// Helper methods
+
+// As in "a, b or c"
+func nameList[T any](choices []named[T]) string {
+	names := lo.Map(choices, func(choice named[T], _ int) string { return choice.name })
+	if len(names) < 2 {
+		return strings.Join(names, "")
+	}
+
+	return strings.Join(names[:len(names)-1], ", ") + " or " + names[len(names)-1]
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestByName(t *testing.T) {
+	choices := []named[int]{{"one", 1}, {"two", 2}, {"three", 3}}
+
+	t.Run("Should find the value going by the name", func(t *testing.T) {
+		got, err := byName("number", choices, "two")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, got)
+	})
+
+	t.Run("Should list every name when the name is unknown", func(t *testing.T) {
+		_, err := byName("number", choices, "four")
+
+		assert.EqualError(t, err, `unknown number "four", expected one, two or three`)
+	})
+}
+
+func TestListByName(t *testing.T) {
+	choices := []named[int]{{"one", 1}, {"two", 2}, {"three", 3}}
+
+	t.Run("Should find the values of a comma separated list in order", func(t *testing.T) {
+		got, err := listByName("number", choices, "three, one")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{3, 1}, got)
+	})
+
+	t.Run("Should find none in an empty list", func(t *testing.T) {
+		got, err := listByName("number", choices, "")
+
+		assert.NoError(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("Should fail on any unknown name", func(t *testing.T) {
+		_, err := listByName("number", choices, "one,four")
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/samber/lo"
+
+	"github.com/whtlnv/blackjack-model-trainer/pkg/blackjack"
+	"github.com/whtlnv/blackjack-model-trainer/pkg/genetics"
+)
+
+type objective func(statistics blackjack.PlayerStatistics) float64
+
+// Every objective is maximized, so the ones to keep low are negated
+var objectiveChoices = []named[objective]{
+	{"ev", func(statistics blackjack.PlayerStatistics) float64 { return statistics.ExpectedValue }},
+	{"ruin", func(statistics blackjack.PlayerStatistics) float64 { return -statistics.RiskOfRuin }},
+	{"sd", func(statistics blackjack.PlayerStatistics) float64 { return -statistics.StandardDeviation }},
+}
+
+// Players sit at the table in the order of their candidates, whose chromosomes carry on
+func candidateObjectives(players []blackjack.Playerish, generation []*genetics.Candidate, objectives []objective) []*genetics.ObjectiveCandidate {
+	return lo.Map(players, func(player blackjack.Playerish, i int) *genetics.ObjectiveCandidate {
+		statistics := player.GetStatistics()
+
+		return &genetics.ObjectiveCandidate{
+			Chromosome: generation[i].Chromosome,
+			Objectives: lo.Map(objectives, func(objective objective, _ int) float64 { return objective(statistics) }),
+		}
+	})
+}
+
+// Replaces the strategy files of the directory with one per candidate of the front
+func saveParetoFront(front []*genetics.ObjectiveCandidate, dir string) error {
+	err := os.RemoveAll(dir)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(dir, 0755)
+	if err != nil {
+		return err
+	}
+
+	for i, candidate := range front {
+		strategy, err := blackjack.NewStrategy(candidate.Chromosome.Raw())
+		if err != nil {
+			return err
+		}
+
+		err = blackjack.SaveStrategyFile(filepath.Join(dir, fmt.Sprintf("front-%03d.strategy", i+1)), strategy)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
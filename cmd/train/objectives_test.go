@@ -0,0 +1,57 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/whtlnv/blackjack-model-trainer/pkg/blackjack"
+	"github.com/whtlnv/blackjack-model-trainer/pkg/genetics"
+)
+
+func TestObjectives(t *testing.T) {
+	statistics := blackjack.PlayerStatistics{ExpectedValue: 0.5, RiskOfRuin: 0.1, StandardDeviation: 12}
+
+	t.Run("Should maximize EV and minimize risk of ruin and spread", func(t *testing.T) {
+		objectives, err := listByName("objective", objectiveChoices, "ev, ruin,sd")
+		assert.NoError(t, err)
+
+		got := []float64{}
+		for _, objective := range objectives {
+			got = append(got, objective(statistics))
+		}
+
+		assert.Equal(t, []float64{0.5, -0.1, -12}, got)
+	})
+}
+
+func TestSaveParetoFront(t *testing.T) {
+	ideal, err := blackjack.LoadStrategyFile(idealStrategyPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	chromosome := genetics.NewChromosome(ideal.GetEncodedStrategy(), blackjack.GetSequencing())
+	front := []*genetics.ObjectiveCandidate{{Chromosome: chromosome}, {Chromosome: chromosome}}
+
+	t.Run("Should save a strategy file per candidate of the front", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "pareto-front")
+
+		assert.NoError(t, saveParetoFront(front, dir))
+
+		saved, err := blackjack.LoadStrategyFile(filepath.Join(dir, "front-002.strategy"))
+		assert.NoError(t, err)
+		assert.Equal(t, ideal.GetEncodedStrategy(), saved.GetEncodedStrategy())
+	})
+
+	t.Run("Should replace the front saved before", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "pareto-front")
+		assert.NoError(t, saveParetoFront(front, dir))
+
+		assert.NoError(t, saveParetoFront(front[:1], dir))
+
+		files, _ := os.ReadDir(dir)
+		assert.Len(t, files, 1)
+	})
+}
@@ -0,0 +1,13 @@
+package main
+
+import (
+	"github.com/whtlnv/blackjack-model-trainer/pkg/genetics"
+)
+
+var scalingChoices = []named[genetics.Scalerish]{
+	{"max", genetics.MaxScaling{}},
+	{"min-max", genetics.MinMaxScaling{}},
+	{"sigma", genetics.SigmaScaling{}},
+	{"rank", genetics.RankScaling{}},
+	{"boltzmann", genetics.BoltzmannScaling{Temperature: 0.25}},
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/whtlnv/blackjack-model-trainer/pkg/genetics"
+)
+
+func TestScalingChoices(t *testing.T) {
+	candidates := func() []*genetics.Candidate {
+		return []*genetics.Candidate{{Fitness: 1}, {Fitness: 2}, {Fitness: 4}}
+	}
+	fitness := func(candidates []*genetics.Candidate) []float64 {
+		return lo.Map(candidates, func(candidate *genetics.Candidate, _ int) float64 { return candidate.Fitness })
+	}
+
+	for _, choice := range scalingChoices {
+		testCase := choice
+		t.Run("Should keep the fitter candidates ahead scaling by "+testCase.name, func(t *testing.T) {
+			scaled := fitness(testCase.value.Scale(candidates()))
+
+			assert.Less(t, scaled[0], scaled[1])
+			assert.Less(t, scaled[1], scaled[2])
+			assert.Equal(t, 1.0, scaled[2])
+		})
+	}
+
+	testCases := []struct {
+		desc string
+		name string
+		want []float64
+	}{
+		{desc: "Should divide by the maximum", name: "max", want: []float64{0.25, 0.5, 1}},
+		{desc: "Should spread from the minimum to the maximum", name: "min-max", want: []float64{0, 1.0 / 3, 1}},
+		{desc: "Should scale by rank", name: "rank", want: []float64{0, 0.5, 1}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.desc, func(t *testing.T) {
+			scaling, err := byName("scaling", scalingChoices, testCase.name)
+			assert.NoError(t, err)
+
+			assert.InDeltaSlice(t, testCase.want, fitness(scaling.Scale(candidates())), 1e-9)
+		})
+	}
+
+	t.Run("Should favour the fittest more than min-max with Boltzmann scaling", func(t *testing.T) {
+		scaling, _ := byName("scaling", scalingChoices, "boltzmann")
+
+		assert.Less(t, fitness(scaling.Scale(candidates()))[1], 1.0/3)
+	})
+}
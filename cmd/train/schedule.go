@@ -0,0 +1,33 @@
+package main
+
+import (
+	"github.com/whtlnv/blackjack-model-trainer/pkg/genetics"
+)
+
+// Schedules starting at the mutation rate, over a number of generations
+var scheduleChoices = []named[func(mutationRate float64, generations int) genetics.MutationScheduleish]{
+	{"constant", func(mutationRate float64, generations int) genetics.MutationScheduleish {
+		return genetics.ConstantSchedule{MutationRate: mutationRate}
+	}},
+	{"linear", func(mutationRate float64, generations int) genetics.MutationScheduleish {
+		return genetics.LinearDecaySchedule{Start: mutationRate, End: mutationRate / 10, Generations: generations}
+	}},
+	{"exponential", func(mutationRate float64, generations int) genetics.MutationScheduleish {
+		return genetics.ExponentialDecaySchedule{Start: mutationRate, Decay: 0.97, Floor: mutationRate / 10}
+	}},
+}
+
+// A schedule starting at the mutation rate, boosted threefold while diversity is below the threshold, if any
+func mutationSchedule(name string, mutationRate float64, generations int, diversityThreshold float64) (genetics.MutationScheduleish, error) {
+	newSchedule, err := byName("mutation schedule", scheduleChoices, name)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule := newSchedule(mutationRate, generations)
+	if diversityThreshold > 0 {
+		schedule = genetics.DiversityBoostSchedule{Base: schedule, Threshold: diversityThreshold, Factor: 3}
+	}
+
+	return schedule, nil
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"math"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/whtlnv/blackjack-model-trainer/internal/randomizer"
+	"github.com/whtlnv/blackjack-model-trainer/pkg/blackjack"
+	"github.com/whtlnv/blackjack-model-trainer/pkg/genetics"
+)
+
+func TestMutationSchedule(t *testing.T) {
+	testCases := []struct {
+		desc       string
+		name       string
+		threshold  float64
+		generation int
+		diversity  float64
+		want       float64
+	}{
+		{desc: "Should keep the rate", name: "constant", generation: 50, diversity: 1, want: 0.1},
+		{desc: "Should decay linearly halfway", name: "linear", generation: 50, diversity: 1, want: 0.055},
+		{desc: "Should decay linearly to a tenth by the last generation", name: "linear", generation: 100, diversity: 1, want: 0.01},
+		{desc: "Should decay exponentially", name: "exponential", generation: 10, diversity: 1, want: 0.1 * math.Pow(0.97, 10)},
+		{desc: "Should decay exponentially no further than a tenth", name: "exponential", generation: 1000, diversity: 1, want: 0.01},
+		{desc: "Should triple the rate when diversity collapses", name: "constant", threshold: 0.2, generation: 50, diversity: 0.1, want: 0.3},
+		{desc: "Should keep the rate while diversity holds", name: "constant", threshold: 0.2, generation: 50, diversity: 0.5, want: 0.1},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.desc, func(t *testing.T) {
+			schedule, err := mutationSchedule(testCase.name, 0.1, 100, testCase.threshold)
+			assert.NoError(t, err)
+
+			assert.InDelta(t, testCase.want, schedule.Rate(testCase.generation, testCase.diversity), 1e-9)
+		})
+	}
+
+	t.Run("Should fail on an unknown schedule", func(t *testing.T) {
+		_, err := mutationSchedule("cosine", 0.1, 100, 0)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestSelfAdaptiveStrategy(t *testing.T) {
+	ideal, err := blackjack.LoadStrategyFile(idealStrategyPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sequencing := blackjack.GetSequencing()
+
+	t.Run("Should carry the rate in a gene of the rate levels", func(t *testing.T) {
+		assert.Equal(t, genetics.RateGeneBases, sequencing[blackjack.RateGene()])
+	})
+
+	t.Run("Should keep the rate through crossover and a save and load", func(t *testing.T) {
+		mother := genetics.NewChromosome(append([]byte{}, ideal.GetEncodedStrategy()...), sequencing).WithMutationRate(blackjack.RateGene(), 0.05)
+		father := genetics.NewChromosome(append([]byte{}, ideal.GetEncodedStrategy()...), sequencing).WithMutationRate(blackjack.RateGene(), 0.05)
+
+		child := mother.Merge(father, 0.1, randomizer.NewRandomizer(1))
+		assert.InEpsilon(t, mother.MutationRate(), child.MutationRate(), 0.5)
+
+		strategy, err := blackjack.NewStrategy(child.Raw())
+		assert.NoError(t, err)
+
+		path := filepath.Join(t.TempDir(), "child.strategy")
+		assert.NoError(t, blackjack.SaveStrategyFile(path, strategy))
+		loaded, err := blackjack.LoadStrategyFile(path)
+		assert.NoError(t, err)
+
+		reloaded := genetics.NewChromosome(loaded.GetEncodedStrategy(), sequencing).SelfAdaptive(blackjack.RateGene(), 0.1)
+		assert.Equal(t, child.MutationRate(), reloaded.MutationRate())
+	})
+
+	t.Run("Should keep the rate gene at none without self-adaptation", func(t *testing.T) {
+		sessionOptions := defaultTrainingOptions()
+		sessionOptions.PopulationSize = 10
+		sessionOptions.BlockMutation = 1
+		options, err := generationOptions(sessionOptions)
+		assert.NoError(t, err)
+
+		random := randomizer.NewRandomizer(1)
+		first := genetics.NewGenerationFromPrevious([]*genetics.Candidate{}, sequencing, options, random)
+		for _, candidate := range first {
+			candidate.Fitness = 1
+		}
+		second := genetics.NewGenerationFromPrevious(first, sequencing, options, random)
+
+		for _, candidate := range append(first, second...) {
+			assert.Equal(t, byte('-'), candidate.Chromosome.Raw()[blackjack.RateGene()])
+		}
+	})
+}
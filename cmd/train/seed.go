@@ -0,0 +1,39 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/whtlnv/blackjack-model-trainer/pkg/blackjack"
+)
+
+type stringsFlag struct {
+	values *[]string
+}
+
+func (flag stringsFlag) String() string {
+	if flag.values == nil {
+		return ""
+	}
+
+	return strings.Join(*flag.values, " ")
+}
+
+func (flag stringsFlag) Set(value string) error {
+	*flag.values = append(*flag.values, value)
+	return nil
+}
+
+// The encoded strategies of the seed files, in the order given
+func seedChromosomes(paths []string) ([][]byte, error) {
+	seeds := [][]byte{}
+	for _, path := range paths {
+		strategy, err := blackjack.LoadStrategyFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		seeds = append(seeds, strategy.GetEncodedStrategy())
+	}
+
+	return seeds, nil
+}
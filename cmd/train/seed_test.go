@@ -0,0 +1,38 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/whtlnv/blackjack-model-trainer/pkg/blackjack"
+)
+
+func TestSeedOptions(t *testing.T) {
+	t.Run("Should collect every seed file", func(t *testing.T) {
+		paths := []string{}
+		flag := stringsFlag{&paths}
+
+		flag.Set("ideal.strategy")
+		flag.Set("other.strategy")
+
+		assert.Equal(t, []string{"ideal.strategy", "other.strategy"}, paths)
+		assert.Equal(t, "ideal.strategy other.strategy", flag.String())
+	})
+
+	t.Run("Should load the seeds as chromosomes", func(t *testing.T) {
+		seeds, err := seedChromosomes([]string{idealStrategyPath})
+		assert.NoError(t, err)
+
+		ideal, _ := blackjack.LoadStrategyFile(idealStrategyPath)
+
+		assert.Equal(t, [][]byte{ideal.GetEncodedStrategy()}, seeds)
+		assert.Len(t, seeds[0], len(blackjack.GetSequencing()))
+	})
+
+	t.Run("Should fail on a missing file", func(t *testing.T) {
+		_, err := seedChromosomes([]string{"missing.strategy"})
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,14 @@
+package main
+
+import (
+	"github.com/whtlnv/blackjack-model-trainer/pkg/genetics"
+)
+
+// The fitness cutoff when nil
+var selectorChoices = []named[genetics.Selectorish]{
+	{"cutoff", nil},
+	{"tournament", genetics.TournamentSelector{Size: 3}},
+	{"roulette", genetics.RouletteSelector{}},
+	{"rank", genetics.RankSelector{}},
+	{"sus", genetics.StochasticUniversalSelector{}},
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/whtlnv/blackjack-model-trainer/internal/randomizer"
+	"github.com/whtlnv/blackjack-model-trainer/pkg/genetics"
+)
+
+func TestSelectorChoices(t *testing.T) {
+	weak := &genetics.Candidate{Fitness: 0.2}
+	strong := &genetics.Candidate{Fitness: 1}
+
+	t.Run("Should keep the fitness cutoff", func(t *testing.T) {
+		selector, err := byName("selection", selectorChoices, "cutoff")
+
+		assert.NoError(t, err)
+		assert.Nil(t, selector)
+	})
+
+	for _, choice := range selectorChoices {
+		if choice.name == "cutoff" {
+			continue
+		}
+
+		testCase := choice
+		t.Run("Should favour the fitter candidate picking by "+testCase.name, func(t *testing.T) {
+			selected := testCase.value.Select([]*genetics.Candidate{weak, strong}, 1000, randomizer.NewRandomizer(1))
+
+			assert.Greater(t, lo.Count(selected, strong), 650)
+		})
+	}
+
+	t.Run("Should hold tournaments of three", func(t *testing.T) {
+		selector, _ := byName("selection", selectorChoices, "tournament")
+		randomizerMock := new(genetics.RandomizerMock)
+		// the strong candidate only enters as the third contender
+		randomizerMock.On("NumberBetween", 0, 2).Return(0).Twice()
+		randomizerMock.On("NumberBetween", 0, 2).Return(1).Once()
+
+		selected := selector.Select([]*genetics.Candidate{weak, strong}, 1, randomizerMock)
+
+		assert.Equal(t, []*genetics.Candidate{strong}, selected)
+	})
+}
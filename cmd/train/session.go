@@ -1,14 +1,95 @@
 package main
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
 	"time"
 
+	"github.com/samber/lo"
+
 	"github.com/whtlnv/blackjack-model-trainer/pkg/blackjack"
 	"github.com/whtlnv/blackjack-model-trainer/pkg/genetics"
 
 	"github.com/whtlnv/blackjack-model-trainer/internal/randomizer"
 )
 
+type trainingOptions struct {
+	OutputDir          string
+	Generations        int
+	PopulationSize     int
+	HandsPerGeneration int
+	// Strategy file to report agreement with every generation, none to skip it
+	ReferencePath string
+	// Sections held at the values of strategy files, every gene evolves when empty
+	Freeze []freezeOption
+	// Strategy files the first generation starts from, a random one when empty
+	SeedPaths        []string
+	SeedRate         float64
+	SeedMutationRate float64
+	// How parents are picked, see selectorChoices
+	Selection string
+	CloneRate float64
+	// How parents are combined, see crossoverChoices
+	Crossover string
+	// Rates of the mutation operators on top of random genes
+	SwapRate      float64
+	RowCopyRate   float64
+	CreepRate     float64
+	BlockMutation float64
+	// Fittest candidates carried over unchanged every generation
+	Elitism int
+	// How fitness is brought between 0 and 1, see scalingChoices
+	Scaling string
+	// How the mutation rate changes over generations: constant, linear or exponential
+	MutationSchedule string
+	// Mutation is boosted while diversity is below it, never when zero
+	DiversityThreshold float64
+	// Every candidate carries its own mutation rate
+	SelfAdaptive bool
+	// Fitness is shared among candidates closer than this share of genes, never when zero
+	SharingRadius float64
+	// Populations evolving side by side on their own tables, swapping their fittest every MigrationInterval generations
+	Islands           int
+	MigrationInterval int
+	Migrants          int
+	// Where migrants go, see topologyChoices
+	Topology string
+	// Options of each island over the session's, in island order, see islandFlagSet
+	IslandOptions []string
+	// Comma separated objectives to evolve with NSGA-II instead of a single fitness, see objectiveChoices
+	Objectives string
+	// Comma separated behaviours to keep a MAP-Elites archive over instead of a population, see descriptorChoices
+	MapElites     string
+	MapElitesBins int
+}
+
+func defaultTrainingOptions() trainingOptions {
+	return trainingOptions{
+		OutputDir:          "output",
+		Generations:        100,
+		PopulationSize:     100,
+		HandsPerGeneration: 1000,
+		SeedRate:           1.0,
+		SeedMutationRate:   0.05,
+		Selection:          "cutoff",
+		CloneRate:          0.1,
+		Crossover:          "uniform",
+		SwapRate:           0.005,
+		RowCopyRate:        0.01,
+		CreepRate:          0.05,
+		BlockMutation:      0.01,
+		Elitism:            2,
+		Scaling:            "max",
+		MutationSchedule:   "constant",
+		Islands:            1,
+		MigrationInterval:  10,
+		Migrants:           2,
+		Topology:           "ring",
+		MapElitesBins:      10,
+	}
+}
+
 func createPlayer(chromosome *genetics.Chromosome) blackjack.Playerish {
 	strategy, error := blackjack.NewStrategy(chromosome.Raw())
 	if error != nil {
@@ -28,14 +109,15 @@ func sessionPlayers(candidates []*genetics.Candidate) []blackjack.Playerish {
 	return players
 }
 
-func candidateFitness(players []blackjack.Playerish, sequence [][]byte) []*genetics.Candidate {
+// Players sit at the table in the order of their candidates, whose chromosomes carry on
+func candidateFitness(players []blackjack.Playerish, generation []*genetics.Candidate) []*genetics.Candidate {
 	candidates := []*genetics.Candidate{}
 
-	for _, player := range players {
+	for i, player := range players {
 		statistics := player.GetStatistics()
 
 		candidates = append(candidates, &genetics.Candidate{
-			Chromosome: genetics.NewChromosome(statistics.Strategy, sequence),
+			Chromosome: generation[i].Chromosome,
 			Fitness:    BlackjackFitnessFunction(statistics),
 		})
 	}
@@ -46,6 +128,8 @@ func candidateFitness(players []blackjack.Playerish, sequence [][]byte) []*genet
 func printResults(players []blackjack.Playerish, fittedPlayers []*genetics.Candidate) {
 	bankrollSum := 0.0
 	fitnessSum := 0.0
+	betsClamped := 0
+	betsRefused := 0
 
 	maxBankroll := 0.0
 	maxGamesPlayed := 0
@@ -59,6 +143,8 @@ func printResults(players []blackjack.Playerish, fittedPlayers []*genetics.Candi
 
 		bankrollSum += statistics.Bankroll
 		fitnessSum += fitness
+		betsClamped += statistics.BetsClamped
+		betsRefused += statistics.BetsRefused
 
 		if statistics.Bankroll > maxBankroll {
 			maxBankroll = statistics.Bankroll
@@ -72,9 +158,12 @@ func printResults(players []blackjack.Playerish, fittedPlayers []*genetics.Candi
 			maxGamesWon = statistics.GamesWon
 		}
 
-		winRate := float64(statistics.GamesWon) / float64(statistics.GamesPlayed)
-		if winRate > maxWinRate {
-			maxWinRate = winRate
+		// a player who never played has no win rate
+		if statistics.GamesPlayed > 0 {
+			winRate := float64(statistics.GamesWon) / float64(statistics.GamesPlayed)
+			if winRate > maxWinRate {
+				maxWinRate = winRate
+			}
 		}
 
 		if fitness > maxFitness {
@@ -90,44 +179,297 @@ func printResults(players []blackjack.Playerish, fittedPlayers []*genetics.Candi
 	println("Max games played:", maxGamesPlayed)
 	println("Max games won:", maxGamesWon)
 	println("Max win rate:", maxWinRate)
+	println("Bets clamped to table limits:", betsClamped)
+	println("Bets refused by table limits:", betsRefused)
 
 	println("Average fitness:", averageFitness)
 	println("Max fitness:", maxFitness)
+
+	diversity := genetics.MeasureDiversity(fittedPlayers)
+	println("Genotype diversity:", diversity.Diversity)
+	println("Mean Hamming distance:", diversity.MeanHammingDistance)
+	println("Mean gene entropy:", diversity.MeanGeneEntropy)
+	println("Unique genotypes:", diversity.UniqueGenotypes)
 }
 
-func trainingSession() {
-	generations := 100
-	sequence := blackjack.GetSequencing()
+func printIslands(islands []*genetics.Island) {
+	for k, island := range islands {
+		maxFitness := lo.Max(lo.Map(island.Population, func(candidate *genetics.Candidate, _ int) float64 { return candidate.Fitness }))
+		println("Island", k+1, "max fitness:", maxFitness, "diversity:", genetics.GenotypeDiversity(island.Population))
+	}
+}
+
+func saveBestStrategy(fittedPlayers []*genetics.Candidate, outputDir string, generation int) {
+	best := lo.MaxBy(fittedPlayers, func(a *genetics.Candidate, b *genetics.Candidate) bool {
+		return a.Fitness > b.Fitness
+	})
+
+	strategy, error := blackjack.NewStrategy(best.Chromosome.Raw())
+	if error != nil {
+		panic(error)
+	}
+
+	path := filepath.Join(outputDir, fmt.Sprintf("generation-%03d.strategy", generation))
+	error = blackjack.SaveStrategyFile(path, strategy)
+	if error != nil {
+		panic(error)
+	}
+}
+
+func printAgreement(fittedPlayers []*genetics.Candidate, reference blackjack.Strategyish) {
+	agreementSum := 0.0
+	bestAgreement := 0.0
+	bestFitness := 0.0
+
+	for i, candidate := range fittedPlayers {
+		strategy, error := blackjack.NewStrategy(candidate.Chromosome.Raw())
+		if error != nil {
+			panic(error)
+		}
+
+		agreement := blackjack.DiffStrategies(reference, strategy).Agreement()
+		agreementSum += agreement
+
+		if i == 0 || candidate.Fitness > bestFitness {
+			bestFitness = candidate.Fitness
+			bestAgreement = agreement
+		}
+	}
+
+	println("Average agreement with reference:", agreementSum/float64(len(fittedPlayers)))
+	println("Best candidate agreement with reference:", bestAgreement)
+}
+
+// The options every generation is built with, from the session's
+func generationOptions(sessionOptions trainingOptions) (genetics.GenerationOptions, error) {
+	var err error
 	options := genetics.GenerationOptions{
-		PopulationSize: 100,
+		PopulationSize: sessionOptions.PopulationSize,
 		MutationRate:   0.1,
 		CutoffRate:     0.2,
+		Constraint:     blackjack.IsSensibleGene,
+		Elitism:        sessionOptions.Elitism,
+		Mutation: genetics.MutationOptions{
+			SwapRate:    sessionOptions.SwapRate,
+			RowCopyRate: sessionOptions.RowCopyRate,
+			CreepRate:   sessionOptions.CreepRate,
+			BlockRate:   sessionOptions.BlockMutation,
+			Rows:        geneBlocks(blackjack.RowBlocks()),
+			Numeric:     geneBlocks(blackjack.NumericBlocks()),
+		},
+	}
+
+	options.Freeze, err = freezeMask(sessionOptions.Freeze)
+	if err != nil {
+		return options, err
+	}
+
+	options.Seeds, err = seedChromosomes(sessionOptions.SeedPaths)
+	if err != nil {
+		return options, err
+	}
+	options.SeedRate = sessionOptions.SeedRate
+	options.SeedMutationRate = sessionOptions.SeedMutationRate
+
+	options.Selector, err = byName("selection", selectorChoices, sessionOptions.Selection)
+	if err != nil {
+		return options, err
+	}
+	options.CloneRate = sessionOptions.CloneRate
+
+	newCrossover, err := byName("crossover", crossoverChoices, sessionOptions.Crossover)
+	if err != nil {
+		return options, err
+	}
+	options.Crossover = newCrossover()
+
+	options.Scaling, err = byName("scaling", scalingChoices, sessionOptions.Scaling)
+	if err != nil {
+		return options, err
+	}
+
+	options.Schedule, err = mutationSchedule(sessionOptions.MutationSchedule, options.MutationRate, sessionOptions.Generations, sessionOptions.DiversityThreshold)
+	if err != nil {
+		return options, err
+	}
+	options.SelfAdaptive = sessionOptions.SelfAdaptive
+	options.RateGene = blackjack.RateGene()
+	if !options.SelfAdaptive {
+		// without self-adaptation the rate gene stays at none, whatever the operators
+		if options.Freeze == nil {
+			options.Freeze = make(genetics.FreezeMask, len(blackjack.GetSequencing()))
+		}
+		options.Freeze[options.RateGene] = genetics.RateGeneBases[0]
+	}
+	options.SharingRadius = sessionOptions.SharingRadius
+
+	return options, nil
+}
+
+func trainingSession(sessionOptions trainingOptions) {
+	// main checks them first, this only guards other callers
+	if error := validateOptions(sessionOptions); error != nil {
+		panic(error)
+	}
+
+	generations := sessionOptions.Generations
+	sequence := blackjack.GetSequencing()
+	options, error := generationOptions(sessionOptions)
+	if error != nil {
+		panic(error)
 	}
 	deckSize := 6
 	penetration := 0.5
-	handsPerGeneration := 1000
+	handsPerGeneration := sessionOptions.HandsPerGeneration
+	tableOptions := blackjack.DefaultTableOptions()
+	tableOptions.MinimumBet = 1
+	tableOptions.MaximumBet = 5000
+	tableOptions.ChipDenominations = []float64{1, 5, 25, 100, 500}
+	tableOptions.BetLimitPolicy = blackjack.ClampOutOfLimitBets
+	outputDir := sessionOptions.OutputDir
 
-	seed := time.Now().UnixNano()
-	randomizer := randomizer.NewRandomizer(seed)
+	error = os.MkdirAll(outputDir, 0755)
+	if error != nil {
+		panic(error)
+	}
 
-	fittedPlayers := [](*genetics.Candidate){}
-	for i := 0; i < generations; i++ {
-		currentGen := genetics.NewGenerationFromPrevious(
-			fittedPlayers,
-			sequence,
-			options,
-			randomizer,
-		)
-		players := sessionPlayers(currentGen)
+	var reference blackjack.Strategyish
+	if sessionOptions.ReferencePath != "" {
+		reference, error = blackjack.LoadStrategyFile(sessionOptions.ReferencePath)
+		if error != nil {
+			panic(error)
+		}
+	}
 
+	migration := genetics.MigrationOptions{
+		Interval: sessionOptions.MigrationInterval,
+		Migrants: sessionOptions.Migrants,
+	}
+	migration.Topology, error = byName("migration topology", topologyChoices, sessionOptions.Topology)
+	if error != nil {
+		panic(error)
+	}
+
+	objectives, error := listByName("objective", objectiveChoices, sessionOptions.Objectives)
+	if error != nil {
+		panic(error)
+	}
+
+	descriptors, error := listByName("descriptor", descriptorChoices, sessionOptions.MapElites)
+	if error != nil {
+		panic(error)
+	}
+
+	playGeneration := func(currentGen []*genetics.Candidate) []blackjack.Playerish {
 		shoe := blackjack.NewShoe(deckSize)
 		shoe.SetPenetration(penetration)
-		table := blackjack.NewTable(players, shoe)
+		table := blackjack.NewTableWithOptions(sessionPlayers(currentGen), shoe, tableOptions)
 		table.RunMany(handsPerGeneration)
 
-		fittedPlayers = candidateFitness(table.Players, sequence)
+		return table.Players
+	}
+
+	islandOptions, error := islandGenerationOptions(sessionOptions, options)
+	if error != nil {
+		panic(error)
+	}
+
+	// every island draws from its own stream, so they can evolve at once
+	seed := time.Now().UnixNano()
+	islands := []*genetics.Island{}
+	for i, options := range islandOptions {
+		islands = append(islands, &genetics.Island{
+			Population: []*genetics.Candidate{},
+			Options:    options,
+			Randomizer: randomizer.NewRandomizer(seed + int64(i)),
+		})
+	}
+
+	if len(descriptors) > 0 {
+		archive := genetics.NewArchive(descriptorBins(descriptors, sessionOptions.MapElitesBins))
+		for i := 0; i < generations; i++ {
+			generationOptions := options
+			generationOptions.Generation = i
+
+			currentGen := genetics.NewMapElitesGeneration(archive, sequence, generationOptions, islands[0].Randomizer)
+			players := playGeneration(currentGen)
+			fittedPlayers := candidateFitness(players, currentGen)
+
+			for _, entry := range candidateEntries(players, fittedPlayers, descriptors) {
+				archive.Add(entry)
+			}
+
+			printResults(players, fittedPlayers)
+			println("Archive cells filled:", len(archive.Elites()), "coverage:", archive.Coverage())
+			error = saveArchive(archive, filepath.Join(outputDir, "map-elites"))
+			if error != nil {
+				panic(error)
+			}
+
+			println("Gen", i+1, "of", generations)
+		}
+
+		return
+	}
+
+	if len(objectives) > 0 {
+		survivors := []*genetics.ObjectiveCandidate{}
+		for i := 0; i < generations; i++ {
+			generationOptions := options
+			generationOptions.Generation = i
+
+			currentGen := genetics.NewMultiObjectiveGeneration(survivors, sequence, generationOptions, islands[0].Randomizer)
+			players := playGeneration(currentGen)
+
+			// parents and offspring compete to survive
+			survivors = genetics.NSGA2Survivors(append(survivors, candidateObjectives(players, currentGen, objectives)...), options.PopulationSize)
+			front := genetics.ParetoFront(survivors)
+
+			printResults(players, candidateFitness(players, currentGen))
+			println("Pareto front size:", len(front))
+			error = saveParetoFront(front, filepath.Join(outputDir, "pareto-front"))
+			if error != nil {
+				panic(error)
+			}
 
-		printResults(table.Players, fittedPlayers)
+			println("Gen", i+1, "of", generations)
+		}
+
+		return
+	}
+
+	for i := 0; i < generations; i++ {
+		for k, island := range islands {
+			islandOptions := island.Options
+			islandOptions.Generation = i
+			rate := genetics.EffectiveMutationRate(island.Population, islandOptions)
+			if len(islands) > 1 {
+				println("Island", k+1, "mutation rate:", rate)
+			} else {
+				println("Mutation rate:", rate)
+			}
+		}
+
+		tablePlayers := make([][]blackjack.Playerish, len(islands))
+		genetics.EvolveIslands(islands, sequence, i, migration, func(k int, currentGen []*genetics.Candidate) []*genetics.Candidate {
+			tablePlayers[k] = playGeneration(currentGen)
+			return candidateFitness(tablePlayers[k], currentGen)
+		})
+
+		players := lo.Flatten(tablePlayers)
+		fittedPlayers := lo.FlatMap(islands, func(island *genetics.Island, _ int) []*genetics.Candidate { return island.Population })
+		if options.SelfAdaptive {
+			println("Average self-adaptive mutation rate:", genetics.AverageMutationRate(fittedPlayers))
+		}
+		if len(islands) > 1 {
+			printIslands(islands)
+		}
+
+		printResults(players, fittedPlayers)
+		if reference != nil {
+			printAgreement(fittedPlayers, reference)
+		}
+		saveBestStrategy(fittedPlayers, outputDir, i+1)
 
 		println("Gen", i+1, "of", generations)
 	}
@@ -0,0 +1,10 @@
+package main
+
+import (
+	"github.com/whtlnv/blackjack-model-trainer/pkg/genetics"
+)
+
+var topologyChoices = []named[genetics.MigrationTopologyish]{
+	{"ring", genetics.RingTopology{}},
+	{"full", genetics.FullyConnectedTopology{}},
+}
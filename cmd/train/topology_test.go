@@ -0,0 +1,29 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTopologyChoices(t *testing.T) {
+	testCases := []struct {
+		desc   string
+		name   string
+		island int
+		want   []int
+	}{
+		{desc: "Should send migrants to the next island", name: "ring", island: 1, want: []int{2}},
+		{desc: "Should send migrants from the last island back to the first", name: "ring", island: 2, want: []int{0}},
+		{desc: "Should send migrants to every other island", name: "full", island: 1, want: []int{0, 2}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.desc, func(t *testing.T) {
+			topology, err := byName("migration topology", topologyChoices, testCase.name)
+			assert.NoError(t, err)
+
+			assert.Equal(t, testCase.want, topology.Destinations(testCase.island, 3))
+		})
+	}
+}
@@ -25,3 +25,7 @@ func (r *Randomizer) PickOne(options []byte) byte {
 func (r *Randomizer) NumberBetween(min, max int) int {
 	return r.generator.Intn(max-min) + min
 }
+
+func (r *Randomizer) Float() float64 {
+	return r.generator.Float64()
+}
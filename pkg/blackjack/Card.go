@@ -68,3 +68,16 @@ func (c Card) Value() []int {
 
 	return []int{int(c.rank)}
 }
+
+// Hi-Lo count value of the card, ignoring whether it is face down
+func (c Card) CountValue() int {
+	if c.rank >= Two && c.rank <= Six {
+		return 1
+	}
+
+	if c.rank >= Seven && c.rank <= Nine {
+		return 0
+	}
+
+	return -1
+}
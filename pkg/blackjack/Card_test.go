@@ -53,3 +53,26 @@ func TestUpcardValue(t *testing.T) {
 
 	assert.ElementsMatch(t, want, got)
 }
+
+func TestCardCountValue(t *testing.T) {
+	testCases := []struct {
+		desc string
+		rank Rank
+		want int
+	}{
+		{desc: "Low cards count +1", rank: Two, want: 1},
+		{desc: "Six counts +1", rank: Six, want: 1},
+		{desc: "Neutral cards count 0", rank: Seven, want: 0},
+		{desc: "Nine counts 0", rank: Nine, want: 0},
+		{desc: "Tens count -1", rank: Ten, want: -1},
+		{desc: "Face cards count -1", rank: King, want: -1},
+		{desc: "Aces count -1", rank: Ace, want: -1},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.desc, func(t *testing.T) {
+			card := NewCard(testCase.rank, Hearts)
+			assert.Equal(t, testCase.want, card.CountValue())
+		})
+	}
+}
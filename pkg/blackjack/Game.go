@@ -3,6 +3,7 @@ package blackjack
 type Game struct {
 	hand      Hand
 	bet       int
+	spot      int
 	IsDoubled bool
 	IsSplit   bool
 }
@@ -46,37 +47,38 @@ func (game *Game) Split() *Game {
 	splitGame := NewGame(game.bet)
 	splitGame.SetHand(Hand{splitCard})
 	splitGame.IsSplit = true
+	splitGame.spot = game.spot
 
 	return splitGame
 }
 
 func (game *Game) Resolve(dealerHand *Hand) float64 {
-	dealerScore, _ := dealerHand.Score()
-	playerScore, _ := game.hand.Score()
+	dealerState := dealerHand.State()
+	playerState := game.hand.State()
 	castedBet := float64(game.bet)
 
 	// player busted, you lose
-	if playerScore.Low > 21 {
+	if playerState.IsBusted {
 		return 0
 	}
 
 	// blackjack, you win
-	if playerScore.High == 21 && len(game.hand) == 2 {
+	if playerState.IsBlackjack {
 		return castedBet * 2.5
 	}
 
 	// dealer busted, you win
-	if dealerScore.Low > 21 {
+	if dealerState.IsBusted {
 		return castedBet * 2
 	}
 
 	// better hand, you win
-	if playerScore.High > dealerScore.High {
+	if playerState.Total > dealerState.Total {
 		return castedBet * 2
 	}
 
 	// same value, you push
-	if playerScore.High == dealerScore.High {
+	if playerState.Total == dealerState.Total {
 		return castedBet
 	}
 
@@ -8,6 +8,26 @@ type HandScore struct {
 	High int
 }
 
+type HandCategory int
+
+const (
+	HardHand HandCategory = iota
+	SoftHand
+	PairHand
+)
+
+// Everything a strategy needs to know about a hand. Total is the best non-busted
+// total, or the lowest total for a busted hand. HardTotal counts every ace as 1.
+type HandState struct {
+	Category     HandCategory
+	Total        int
+	HardTotal    int
+	Cards        int
+	IsSplittable bool
+	IsBlackjack  bool
+	IsBusted     bool
+}
+
 func (hand *Hand) Deal(card Card) {
 	*hand = append(*hand, card)
 }
@@ -41,11 +61,6 @@ func (hand *Hand) Score() (score HandScore, isBusted bool) {
 		return value <= 21
 	})
 
-	// TODO: remove this when confident
-	if len(notBusted) > 2 {
-		panic("A hand should never have more than 2 values")
-	}
-
 	if len(notBusted) > 0 {
 		score = HandScore{Low: lo.Min(notBusted), High: lo.Max(notBusted)}
 		isBusted = false
@@ -57,8 +72,53 @@ func (hand *Hand) Score() (score HandScore, isBusted bool) {
 	return score, isBusted
 }
 
+func (hand *Hand) State() HandState {
+	score, isBusted := hand.Score()
+	cards := len(*hand)
+
+	state := HandState{
+		Category:  HardHand,
+		Total:     score.High,
+		HardTotal: score.Low,
+		Cards:     cards,
+		IsBusted:  isBusted,
+	}
+
+	if isBusted {
+		state.Total = score.Low
+		return state
+	}
+
+	// pairs take precedence over soft hands, as {A,A} is split rather than played as soft 12
+	if hand.IsPair() {
+		state.Category = PairHand
+		state.IsSplittable = true
+	} else if score.Low != score.High {
+		state.Category = SoftHand
+	}
+
+	state.IsBlackjack = cards == 2 && score.High == 21
+
+	return state
+}
+
+// The row of the strategy table for the hand's category. Pairs are keyed by their
+// lowest total, so {A,A} is 2 rather than soft 12.
+func (state HandState) Row() PlayerHand {
+	if state.Category == PairHand {
+		return PlayerHand(state.HardTotal)
+	}
+
+	return PlayerHand(state.Total)
+}
+
 func (hand *Hand) IsPair() bool {
-	return (*hand)[0].rank == (*hand)[1].rank
+	if len(*hand) != 2 {
+		return false
+	}
+
+	first, second := (*hand)[0], (*hand)[1]
+	return first.rank == second.rank && !first.hole && !second.hole
 }
 
 func (hand *Hand) HasSoftValue() bool {
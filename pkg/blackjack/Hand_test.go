@@ -153,6 +153,125 @@ func TestHandScore(t *testing.T) {
 	})
 }
 
+func TestHandState(t *testing.T) {
+	hand := func(ranks ...Rank) Hand {
+		hand := Hand{}
+		for i, rank := range ranks {
+			hand = append(hand, NewCard(rank, SUITS[i%len(SUITS)]))
+		}
+		return hand
+	}
+
+	testCases := []struct {
+		desc string
+		hand Hand
+		want HandState
+	}{
+		{desc: "Single card (split hand)", hand: hand(Eight), want: HandState{HardHand, 8, 8, 1, false, false, false}},
+		{desc: "Single ace (split hand)", hand: hand(Ace), want: HandState{SoftHand, 11, 1, 1, false, false, false}},
+		{desc: "Hard 5", hand: hand(Two, Three), want: HandState{HardHand, 5, 5, 2, false, false, false}},
+		{desc: "Hard 6", hand: hand(Two, Four), want: HandState{HardHand, 6, 6, 2, false, false, false}},
+		{desc: "Hard 7", hand: hand(Two, Five), want: HandState{HardHand, 7, 7, 2, false, false, false}},
+		{desc: "Hard 8", hand: hand(Two, Six), want: HandState{HardHand, 8, 8, 2, false, false, false}},
+		{desc: "Hard 9", hand: hand(Four, Five), want: HandState{HardHand, 9, 9, 2, false, false, false}},
+		{desc: "Hard 10", hand: hand(Four, Six), want: HandState{HardHand, 10, 10, 2, false, false, false}},
+		{desc: "Hard 11", hand: hand(Five, Six), want: HandState{HardHand, 11, 11, 2, false, false, false}},
+		{desc: "Hard 12", hand: hand(Ten, Two), want: HandState{HardHand, 12, 12, 2, false, false, false}},
+		{desc: "Hard 13", hand: hand(Ten, Three), want: HandState{HardHand, 13, 13, 2, false, false, false}},
+		{desc: "Hard 14", hand: hand(Ten, Four), want: HandState{HardHand, 14, 14, 2, false, false, false}},
+		{desc: "Hard 15", hand: hand(Ten, Five), want: HandState{HardHand, 15, 15, 2, false, false, false}},
+		{desc: "Hard 16", hand: hand(Ten, Six), want: HandState{HardHand, 16, 16, 2, false, false, false}},
+		{desc: "Hard 17", hand: hand(Ten, Seven), want: HandState{HardHand, 17, 17, 2, false, false, false}},
+		{desc: "Hard 18", hand: hand(Ten, Eight), want: HandState{HardHand, 18, 18, 2, false, false, false}},
+		{desc: "Hard 19", hand: hand(Ten, Nine), want: HandState{HardHand, 19, 19, 2, false, false, false}},
+		{desc: "Hard 20 from different ten-valued ranks", hand: hand(King, Queen), want: HandState{HardHand, 20, 20, 2, false, false, false}},
+		{desc: "Hard 21 from three cards", hand: hand(Ten, Five, Six), want: HandState{HardHand, 21, 21, 3, false, false, false}},
+		{desc: "Hard 16 from three cards", hand: hand(Ten, Two, Four), want: HandState{HardHand, 16, 16, 3, false, false, false}},
+		{desc: "Hard 9 from a pair plus a card", hand: hand(Two, Two, Five), want: HandState{HardHand, 9, 9, 3, false, false, false}},
+		{desc: "Hard 17 from a soft hand that would bust", hand: hand(Ace, Six, Ten), want: HandState{HardHand, 17, 17, 3, false, false, false}},
+		{desc: "Soft 13", hand: hand(Ace, Two), want: HandState{SoftHand, 13, 3, 2, false, false, false}},
+		{desc: "Soft 14", hand: hand(Ace, Three), want: HandState{SoftHand, 14, 4, 2, false, false, false}},
+		{desc: "Soft 15", hand: hand(Ace, Four), want: HandState{SoftHand, 15, 5, 2, false, false, false}},
+		{desc: "Soft 16", hand: hand(Ace, Five), want: HandState{SoftHand, 16, 6, 2, false, false, false}},
+		{desc: "Soft 17", hand: hand(Ace, Six), want: HandState{SoftHand, 17, 7, 2, false, false, false}},
+		{desc: "Soft 18", hand: hand(Ace, Seven), want: HandState{SoftHand, 18, 8, 2, false, false, false}},
+		{desc: "Soft 19", hand: hand(Ace, Eight), want: HandState{SoftHand, 19, 9, 2, false, false, false}},
+		{desc: "Soft 20", hand: hand(Ace, Nine), want: HandState{SoftHand, 20, 10, 2, false, false, false}},
+		{desc: "Soft 21 from three cards", hand: hand(Ace, Four, Six), want: HandState{SoftHand, 21, 11, 3, false, false, false}},
+		{desc: "Soft 14 from two aces and a card", hand: hand(Ace, Ace, Two), want: HandState{SoftHand, 14, 4, 3, false, false, false}},
+		{desc: "Blackjack", hand: hand(Ace, King), want: HandState{SoftHand, 21, 11, 2, false, true, false}},
+		{desc: "Pair of aces", hand: hand(Ace, Ace), want: HandState{PairHand, 12, 2, 2, true, false, false}},
+		{desc: "Pair of twos", hand: hand(Two, Two), want: HandState{PairHand, 4, 4, 2, true, false, false}},
+		{desc: "Pair of threes", hand: hand(Three, Three), want: HandState{PairHand, 6, 6, 2, true, false, false}},
+		{desc: "Pair of fours", hand: hand(Four, Four), want: HandState{PairHand, 8, 8, 2, true, false, false}},
+		{desc: "Pair of fives", hand: hand(Five, Five), want: HandState{PairHand, 10, 10, 2, true, false, false}},
+		{desc: "Pair of sixes", hand: hand(Six, Six), want: HandState{PairHand, 12, 12, 2, true, false, false}},
+		{desc: "Pair of sevens", hand: hand(Seven, Seven), want: HandState{PairHand, 14, 14, 2, true, false, false}},
+		{desc: "Pair of eights", hand: hand(Eight, Eight), want: HandState{PairHand, 16, 16, 2, true, false, false}},
+		{desc: "Pair of nines", hand: hand(Nine, Nine), want: HandState{PairHand, 18, 18, 2, true, false, false}},
+		{desc: "Pair of tens", hand: hand(Ten, Ten), want: HandState{PairHand, 20, 20, 2, true, false, false}},
+		{desc: "Pair of kings", hand: hand(King, King), want: HandState{PairHand, 20, 20, 2, true, false, false}},
+		{desc: "Busted", hand: hand(Ten, Six, Nine), want: HandState{HardHand, 25, 25, 3, false, false, true}},
+		{desc: "Busted with an ace", hand: hand(Ace, King, Queen, Two), want: HandState{HardHand, 23, 23, 4, false, false, true}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.desc, func(t *testing.T) {
+			got := testCase.hand.State()
+			assert.Equal(t, testCase.want, got)
+		})
+	}
+
+	t.Run("Should ignore the hole card", func(t *testing.T) {
+		dealerHand := Hand{NewCard(Ace, Spades), NewCard(Ace, Hearts)}
+		dealerHand[1].SetHole()
+
+		got := dealerHand.State()
+		want := HandState{SoftHand, 11, 1, 2, false, false, false}
+
+		assert.Equal(t, want, got)
+	})
+}
+
+func TestHandStateRow(t *testing.T) {
+	hardRows := []PlayerHand{HardFive, HardSix, HardSeven, HardEight, HardNine, HardTen, HardEleven, HardTwelve, HardThirteen, HardFourteen, HardFifteen, HardSixteen, HardSeventeen, HardEighteen, HardNineteen, HardTwenty}
+	softRows := []PlayerHand{SoftThirteen, SoftFourteen, SoftFifteen, SoftSixteen, SoftSeventeen, SoftEighteen, SoftNineteen, SoftTwenty}
+	pairRows := []PlayerHand{PairTwos, PairThrees, PairFours, PairFives, PairSixes, PairSevens, PairEights, PairNines, PairTens, PairAces}
+
+	t.Run("Every two card hand should map to a strategy row or be 21", func(t *testing.T) {
+		for _, first := range RANKS {
+			for _, second := range RANKS {
+				hand := Hand{NewCard(first, Spades), NewCard(second, Hearts)}
+				state := hand.State()
+
+				if state.Total == 21 {
+					assert.True(t, state.IsBlackjack)
+					continue
+				}
+
+				switch state.Category {
+				case PairHand:
+					assert.Contains(t, pairRows, state.Row(), "%v", hand)
+				case SoftHand:
+					assert.Contains(t, softRows, state.Row(), "%v", hand)
+				default:
+					assert.Contains(t, hardRows, state.Row(), "%v", hand)
+				}
+			}
+		}
+	})
+
+	t.Run("Pairs of aces should use the aces row", func(t *testing.T) {
+		hand := Hand{NewCard(Ace, Spades), NewCard(Ace, Hearts)}
+		assert.Equal(t, PairAces, hand.State().Row())
+	})
+
+	t.Run("Soft hands should use their high total", func(t *testing.T) {
+		hand := Hand{NewCard(Ace, Spades), NewCard(Seven, Hearts)}
+		assert.Equal(t, SoftEighteen, hand.State().Row())
+	})
+}
+
 func TestIsPair(t *testing.T) {
 	t.Run("Should return true if pair", func(t *testing.T) {
 		hand := Hand{
@@ -177,6 +296,19 @@ func TestIsPair(t *testing.T) {
 
 		assert.Equal(t, want, got)
 	})
+
+	t.Run("Should return false if the pair has more cards", func(t *testing.T) {
+		hand := Hand{
+			NewCard(Two, Spades),
+			NewCard(Two, Hearts),
+			NewCard(Five, Hearts),
+		}
+
+		got := hand.IsPair()
+		want := false
+
+		assert.Equal(t, want, got)
+	})
 }
 
 func TestHasSoftValue(t *testing.T) {
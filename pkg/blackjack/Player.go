@@ -1,8 +1,16 @@
 package blackjack
 
+import (
+	"math"
+
+	"github.com/samber/lo"
+)
+
 type Playerish interface {
-	Bet() (bool, int)
-	Play(hand Hand, dealerHand Hand, shoe Shoeish) int
+	TakeSeat(options TableOptions)
+	Bet(trueCount int) (spots int, bet int)
+	PlaceSideBets(trueCount int)
+	Play(hands []Hand, dealerHand Hand, shoe Shoeish) int
 	Resolve(dealerHand Hand)
 	GetStatistics() PlayerStatistics
 }
@@ -12,17 +20,46 @@ type Player struct {
 	Bankroll float64
 	Games    []*Game
 
+	RoundsSeen  int
 	GamesSeen   int
 	GamesPlayed int
 	GamesWon    int
 	GamesLost   int
 	GamesPushed int
+
+	BetsRefused int
+	BetsClamped int
+
+	Doubles int
+	Splits  int
+
+	// Sums of what each hand won or lost, and of its square, for the spread of results
+	NetWinnings        float64
+	NetWinningsSquared float64
+
+	SideBets map[SideBetKind]SideBetStatistics
+
+	tableOptions    TableOptions
+	sideBetTriggers []SideBetTrigger
+	placedSideBets  []placedSideBet
+	dealtHands      []Hand
+}
+
+// A side bet riding along the main wager of a spot
+type placedSideBet struct {
+	kind SideBetKind
+	spot int
+	bet  int
 }
 
 type PlayerStatistics struct {
 	Strategy []byte
 
-	GamesSeen   int
+	// Rounds dealt while seated, played or sat out
+	RoundsSeen int
+	// Hands the player could have played: one per spot of the rounds played, one per round sat out
+	GamesSeen int
+	// Spots played to the end, a split spot counting once
 	GamesPlayed int
 	GamesWon    int
 	GamesLost   int
@@ -31,6 +68,20 @@ type PlayerStatistics struct {
 	InitialBankroll float64
 	Bankroll        float64
 	BankrollDelta   float64
+
+	BetsRefused int
+	BetsClamped int
+
+	Doubles int
+	Splits  int
+
+	// Per hand played, 0 without any
+	ExpectedValue     float64
+	StandardDeviation float64
+	// Chance of losing the whole bankroll playing on like this, from 0 to 1
+	RiskOfRuin float64
+
+	SideBets map[SideBetKind]SideBetStatistics
 }
 
 // Factory
@@ -42,32 +93,83 @@ func NewPlayer(strategy Strategyish) *Player {
 
 	player.Games = []*Game{}
 
+	player.SideBets = map[SideBetKind]SideBetStatistics{}
+	player.tableOptions = DefaultTableOptions()
+	player.placedSideBets = []placedSideBet{}
+
 	return player
 }
 
 // Public methods
 
-func (player *Player) Bet() (willPlay bool, bet int) {
-	player.GamesSeen++
+func (player *Player) TakeSeat(options TableOptions) {
+	player.tableOptions = options
+}
+
+func (player *Player) SetSideBets(triggers []SideBetTrigger) {
+	player.sideBetTriggers = triggers
+}
+
+func (player *Player) Bet(trueCount int) (spots int, bet int) {
+	player.RoundsSeen++
+
+	bet, isAllowed := player.limitBet(player.strategy.Bet())
+	spots = player.strategy.Spots(trueCount)
+	if !isAllowed {
+		spots = 0
+	}
+
+	// play as many spots as the bankroll covers
+	for spots > 0 && float64(spots*bet) > player.Bankroll {
+		spots--
+	}
 
-	bet = player.strategy.Bet()
-	if float64(bet) > player.Bankroll {
-		return false, 0
+	if spots == 0 {
+		player.GamesSeen++
+		return 0, 0
 	}
 
-	player.subtractFromBankroll(bet)
-	player.Games = []*Game{NewGame(bet)}
+	player.GamesSeen += spots
+	player.Games = []*Game{}
+	for spot := 0; spot < spots; spot++ {
+		player.subtractFromBankroll(bet)
+
+		game := NewGame(bet)
+		game.spot = spot
+		player.Games = append(player.Games, game)
+	}
 
-	return true, bet
+	return spots, bet
 }
 
-func (player *Player) Play(hand Hand, dealerHand Hand, shoe Shoeish) int {
+// Every triggered side bet on each spot bet on, settled against the cards dealt to that spot
+func (player *Player) PlaceSideBets(trueCount int) {
+	player.placedSideBets = []placedSideBet{}
+
+	// side bets ride along the main wager of a spot
+	for _, game := range player.Games {
+		for _, trigger := range player.sideBetTriggers {
+			if trueCount < trigger.MinTrueCount || float64(trigger.Bet) > player.Bankroll {
+				continue
+			}
+
+			player.subtractFromBankroll(trigger.Bet)
+			player.placedSideBets = append(player.placedSideBets, placedSideBet{trigger.Kind, game.spot, trigger.Bet})
+		}
+	}
+}
+
+func (player *Player) Play(hands []Hand, dealerHand Hand, shoe Shoeish) int {
+	player.dealtHands = append([]Hand{}, hands...)
+
 	if len(player.Games) == 0 {
 		return 0
 	}
 
 	shoeIndex := 0
-	player.Games[0].SetHand(hand)
+	for _, game := range player.Games {
+		game.SetHand(hands[game.spot])
+	}
 
 	for i := 0; i < len(player.Games); i++ {
 		game := player.Games[i]
@@ -78,34 +180,48 @@ func (player *Player) Play(hand Hand, dealerHand Hand, shoe Shoeish) int {
 }
 
 func (player *Player) Resolve(dealerHand Hand) {
-	totalBet := 0
-	winnings := 0.0
+	totalBets := map[int]int{}
+	winnings := map[int]float64{}
 
 	for _, game := range player.Games {
-		totalBet += game.bet
-		winnings += game.Resolve(&dealerHand)
+		totalBets[game.spot] += game.bet
+		winnings[game.spot] += player.tableOptions.RoundPayout(game.Resolve(&dealerHand), game.bet)
 	}
 
-	player.updateStatistics(float64(totalBet), winnings)
+	for spot, totalBet := range totalBets {
+		player.updateStatistics(float64(totalBet), winnings[spot])
+		player.Bankroll += winnings[spot]
+	}
 
-	player.Bankroll += winnings
 	player.Games = []*Game{}
+
+	player.resolveSideBets(dealerHand)
 }
 
 func (player *Player) GetStatistics() PlayerStatistics {
 	gamesPushed := player.GamesPlayed - player.GamesWon - player.GamesLost
 	bankrollDelta := player.Bankroll - player.strategy.GetInitialBankroll()
+	expectedValue, variance := player.netWinningsSpread()
 
 	return PlayerStatistics{
-		Strategy:        player.strategy.GetEncodedStrategy(),
-		GamesSeen:       player.GamesSeen,
-		GamesPlayed:     player.GamesPlayed,
-		GamesWon:        player.GamesWon,
-		GamesLost:       player.GamesLost,
-		GamesPushed:     gamesPushed,
-		InitialBankroll: player.strategy.GetInitialBankroll(),
-		Bankroll:        player.Bankroll,
-		BankrollDelta:   bankrollDelta,
+		Strategy:          player.strategy.GetEncodedStrategy(),
+		RoundsSeen:        player.RoundsSeen,
+		GamesSeen:         player.GamesSeen,
+		GamesPlayed:       player.GamesPlayed,
+		GamesWon:          player.GamesWon,
+		GamesLost:         player.GamesLost,
+		GamesPushed:       gamesPushed,
+		InitialBankroll:   player.strategy.GetInitialBankroll(),
+		Bankroll:          player.Bankroll,
+		BankrollDelta:     bankrollDelta,
+		BetsRefused:       player.BetsRefused,
+		BetsClamped:       player.BetsClamped,
+		Doubles:           player.Doubles,
+		Splits:            player.Splits,
+		ExpectedValue:     expectedValue,
+		StandardDeviation: math.Sqrt(variance),
+		RiskOfRuin:        riskOfRuin(expectedValue, variance, player.Bankroll),
+		SideBets:          lo.Assign(player.SideBets),
 	}
 }
 
@@ -115,18 +231,34 @@ func (player *Player) GetStrategy() Strategyish {
 
 // Private methods
 
+func (player *Player) limitBet(bet int) (limited int, isAllowed bool) {
+	limited, outcome := player.tableOptions.LimitBet(bet)
+
+	switch outcome {
+	case BetRefused:
+		player.BetsRefused++
+		return 0, false
+	case BetClamped:
+		player.BetsClamped++
+	}
+
+	return limited, true
+}
+
 func (player *Player) subtractFromBankroll(bet int) {
 	player.Bankroll -= float64(bet)
 }
 
 func (player *Player) getAction(game *Game, dealerHand Hand) PlayerAction {
+	state := game.hand.State()
+
 	// a split game with 1 card should always hit
-	if len(game.hand) < 2 {
+	if state.Cards < 2 {
 		return Hit
 	}
 
 	// a doubled game with 3 cards should always stand
-	if game.IsDoubled && len(game.hand) > 2 {
+	if game.IsDoubled && state.Cards > 2 {
 		return Stand
 	}
 
@@ -135,7 +267,16 @@ func (player *Player) getAction(game *Game, dealerHand Hand) PlayerAction {
 		return Stand
 	}
 
-	idealAction := player.strategy.Play(game.hand, dealerHand)
+	idealAction := player.strategy.Play(game.hand, dealerHand, PlayContext{IsSplit: game.IsSplit})
+
+	// only a two card pair can be split
+	if idealAction == SplitOrHit && !state.IsSplittable {
+		return Hit
+	}
+
+	if idealAction == SplitOrStand && !state.IsSplittable {
+		return Stand
+	}
 
 	// if the ideal action is to double/split, but we can't afford it, hit instead
 	requiresBet := idealAction == Double || idealAction == SplitOrHit
@@ -147,28 +288,48 @@ func (player *Player) getAction(game *Game, dealerHand Hand) PlayerAction {
 		return Stand
 	}
 
-	// // if the ideal action is to splitOrHit, but we already have, hit instead
-	// if idealAction == SplitOrHit && game.IsSplit {
-	// 	return Hit
-	// }
+	// doubling a split hand is up to the table
+	if idealAction == Double && game.IsSplit && !player.tableOptions.DoubleAfterSplit {
+		return Hit
+	}
 
-	// // if the ideal action is to splitOrStand, but we already have, stand instead
-	// if idealAction == SplitOrStand && game.IsSplit {
-	// 	return Stand
-	// }
+	// if the spot was already split as many times as the table allows, hit or stand instead
+	if idealAction == SplitOrHit && !player.canSplitSpot(game.spot) {
+		return Hit
+	}
+
+	if idealAction == SplitOrStand && !player.canSplitSpot(game.spot) {
+		return Stand
+	}
 
 	return idealAction
 }
 
+func (player *Player) canSplitSpot(spot int) bool {
+	maxSplitHands := player.tableOptions.MaxSplitHands
+	if maxSplitHands == 0 {
+		return true
+	}
+
+	handsInSpot := lo.CountBy(player.Games, func(game *Game) bool { return game.spot == spot })
+	return handsInSpot < maxSplitHands
+}
+
 func (player *Player) split(game *Game) (cardsTaken int) {
 	splitGame := game.Split()
 	player.subtractFromBankroll(splitGame.bet)
-	player.Games = append(player.Games, splitGame)
+	player.Splits++
+
+	// the split hand is played right after the hand it came from, before the next spot
+	index := lo.IndexOf(player.Games, game)
+	player.Games = append(player.Games[:index+1], append([]*Game{splitGame}, player.Games[index+1:]...)...)
+
 	return 0
 }
 
 func (player *Player) double(game *Game, nextCard Card) (cardsTaken int) {
 	player.subtractFromBankroll(game.bet)
+	player.Doubles++
 	game.Double(nextCard)
 	return 1
 }
@@ -220,8 +381,32 @@ func (player *Player) playGame(game *Game, dealerHand Hand, shoe Shoeish, shoeIn
 	return shoeIndex
 }
 
+func (player *Player) resolveSideBets(dealerHand Hand) {
+	for _, placed := range player.placedSideBets {
+		outcome := ResolveSideBet(placed.kind, player.dealtHands[placed.spot], dealerHand)
+		payout := player.tableOptions.SideBetPayTables[placed.kind].Payout(outcome, placed.bet)
+		payout = player.tableOptions.RoundPayout(payout, placed.bet)
+
+		statistics := player.SideBets[placed.kind]
+		statistics.Placed++
+		statistics.Wagered += float64(placed.bet)
+		statistics.Returned += payout
+		if payout > 0 {
+			statistics.Won++
+		}
+		player.SideBets[placed.kind] = statistics
+
+		player.Bankroll += payout
+	}
+
+	player.placedSideBets = []placedSideBet{}
+}
+
 func (player *Player) updateStatistics(bet float64, won float64) {
 	player.GamesPlayed++
+	player.NetWinnings += won - bet
+	player.NetWinningsSquared += (won - bet) * (won - bet)
+
 	if won > bet {
 		player.GamesWon++
 	} else if won < bet {
@@ -230,3 +415,35 @@ func (player *Player) updateStatistics(bet float64, won float64) {
 		player.GamesPushed++
 	}
 }
+
+// Mean and variance of what each hand played won or lost
+func (player *Player) netWinningsSpread() (mean float64, variance float64) {
+	if player.GamesPlayed == 0 {
+		return 0, 0
+	}
+
+	hands := float64(player.GamesPlayed)
+	mean = player.NetWinnings / hands
+	variance = math.Max(player.NetWinningsSquared/hands-mean*mean, 0)
+
+	return mean, variance
+}
+
+// Helper methods
+
+// The gambler's ruin approximation: certain without an edge, none for a player who never wins or loses
+func riskOfRuin(expectedValue float64, variance float64, bankroll float64) float64 {
+	if bankroll <= 0 {
+		return 1
+	}
+
+	if variance == 0 {
+		return lo.Ternary(expectedValue < 0, 1.0, 0.0)
+	}
+
+	if expectedValue <= 0 {
+		return 1
+	}
+
+	return math.Exp(-2 * expectedValue * bankroll / variance)
+}
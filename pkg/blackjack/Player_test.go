@@ -1,6 +1,7 @@
 package blackjack
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -13,8 +14,8 @@ type strategyMock struct {
 	mock.Mock
 }
 
-func (strategy *strategyMock) Play(playerHand Hand, dealerHand Hand) PlayerAction {
-	args := strategy.Called(playerHand, dealerHand)
+func (strategy *strategyMock) Play(playerHand Hand, dealerHand Hand, context PlayContext) PlayerAction {
+	args := strategy.Called(playerHand, dealerHand, context)
 	return args.Get(0).(PlayerAction)
 }
 
@@ -23,6 +24,11 @@ func (strategy *strategyMock) Bet() int {
 	return args.Int(0)
 }
 
+func (strategy *strategyMock) Spots(trueCount int) int {
+	args := strategy.Called(trueCount)
+	return args.Int(0)
+}
+
 func (strategy *strategyMock) GetInitialBankroll() float64 {
 	args := strategy.Called()
 	return args.Get(0).(float64)
@@ -42,6 +48,14 @@ func makeMockStrategy(initialBankroll float64) *strategyMock {
 func makeMockStrategyWithBet(initialBankroll float64, bet int) *strategyMock {
 	strategy := makeMockStrategy(initialBankroll)
 	strategy.On("Bet").Return(bet)
+	strategy.On("Spots", mock.Anything).Return(1)
+	return strategy
+}
+
+func makeMockStrategyWithSpots(initialBankroll float64, bet int, spots int) *strategyMock {
+	strategy := makeMockStrategy(initialBankroll)
+	strategy.On("Bet").Return(bet)
+	strategy.On("Spots", mock.Anything).Return(spots)
 	return strategy
 }
 
@@ -78,6 +92,16 @@ func (shoe *shoeMock) NeedsReshuffle() bool {
 	return args.Bool(0)
 }
 
+func (shoe *shoeMock) RunningCount() int {
+	args := shoe.Called()
+	return args.Int(0)
+}
+
+func (shoe *shoeMock) TrueCount() int {
+	args := shoe.Called()
+	return args.Int(0)
+}
+
 func makeMockShoe(cards []Card, err error) *shoeMock {
 	shoe := &shoeMock{}
 	shoe.On("Peek", mock.Anything).Return(cards, err)
@@ -135,8 +159,8 @@ func TestPlayerBet(t *testing.T) {
 	t.Run("Should decide to play a hand if has funds", func(t *testing.T) {
 		player := NewPlayer(strategy)
 
-		willBet, ammount := player.Bet( /* shoe? */ )
-		assert.True(t, willBet)
+		spots, ammount := player.Bet(0)
+		assert.Equal(t, 1, spots)
 		assert.Equal(t, 1, ammount)
 	})
 
@@ -144,16 +168,16 @@ func TestPlayerBet(t *testing.T) {
 		player := NewPlayer(strategy)
 		player.Bankroll = 0
 
-		willBet, _ := player.Bet( /* shoe? */ )
-		assert.False(t, willBet)
+		spots, _ := player.Bet(0)
+		assert.Equal(t, 0, spots)
 	})
 
 	t.Run("Bet should be deducted from bankroll", func(t *testing.T) {
 		player := NewPlayer(strategy)
 		player.Bankroll = 10
 
-		willBet, _ := player.Bet( /* shoe? */ )
-		assert.True(t, willBet)
+		spots, _ := player.Bet(0)
+		assert.Equal(t, 1, spots)
 		assert.Equal(t, 9.0, player.Bankroll)
 	})
 
@@ -161,7 +185,7 @@ func TestPlayerBet(t *testing.T) {
 		player := NewPlayer(strategy)
 		player.Bankroll = 10
 
-		player.Bet( /* shoe? */ )
+		player.Bet(0)
 		assert.Equal(t, 1, len(player.Games))
 	})
 
@@ -169,16 +193,182 @@ func TestPlayerBet(t *testing.T) {
 		player := NewPlayer(strategy)
 		player.Bankroll = 10
 
-		player.Bet( /* shoe? */ )
+		player.Bet(0)
 		assert.Equal(t, 1, player.Games[0].bet)
 	})
 }
 
+func TestPlayerTableLimits(t *testing.T) {
+	t.Run("Should not play a refused bet", func(t *testing.T) {
+		strategy := makeMockStrategyWithBet(100.0, 1)
+		player := NewPlayer(strategy)
+		player.TakeSeat(TableOptions{MinimumBet: 5})
+
+		spots, bet := player.Bet(0)
+
+		assert.Equal(t, 0, spots)
+		assert.Equal(t, 0, bet)
+		assert.Equal(t, 100.0, player.Bankroll)
+		assert.Equal(t, 1, player.BetsRefused)
+	})
+
+	t.Run("Should play a clamped bet", func(t *testing.T) {
+		strategy := makeMockStrategyWithBet(100.0, 1)
+		player := NewPlayer(strategy)
+		player.TakeSeat(TableOptions{MinimumBet: 5, BetLimitPolicy: ClampOutOfLimitBets})
+
+		spots, bet := player.Bet(0)
+
+		assert.Equal(t, 1, spots)
+		assert.Equal(t, 5, bet)
+		assert.Equal(t, 95.0, player.Bankroll)
+		assert.Equal(t, 1, player.BetsClamped)
+	})
+
+	t.Run("Should report out of limit bets in the statistics", func(t *testing.T) {
+		strategy := makeMockStrategyWithBet(100.0, 1)
+		strategy.On("GetEncodedStrategy").Return([]byte("AAA"))
+		player := NewPlayer(strategy)
+		player.TakeSeat(TableOptions{MinimumBet: 5})
+
+		player.Bet(0)
+		player.Bet(0)
+
+		assert.Equal(t, 2, player.GetStatistics().BetsRefused)
+		assert.Equal(t, 0, player.GetStatistics().BetsClamped)
+	})
+
+	t.Run("Should round blackjack payouts to the table chips", func(t *testing.T) {
+		strategy := makeMockStrategyWithBet(100.0, 5)
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Stand)
+		player := NewPlayer(strategy)
+		player.TakeSeat(TableOptions{ChipDenominations: []float64{1, 5}})
+
+		player.Bet(0)
+		dealerHand := Hand{NewCard(Ten, Diamonds), NewCard(Eight, Diamonds)}
+		playerHand := Hand{NewCard(Ace, Clubs), NewCard(King, Hearts)}
+		player.Play([]Hand{playerHand}, dealerHand, &shoeMock{})
+		player.Resolve(dealerHand)
+
+		assert.Equal(t, 107.0, player.Bankroll)
+	})
+}
+
+func TestPlayerMultipleSpots(t *testing.T) {
+	t.Run("Should ask the strategy for spots with the true count", func(t *testing.T) {
+		strategy := makeMockStrategyWithSpots(100.0, 1, 2)
+		player := NewPlayer(strategy)
+
+		player.Bet(3)
+
+		strategy.AssertCalled(t, "Spots", 3)
+	})
+
+	t.Run("Should create a game per spot", func(t *testing.T) {
+		strategy := makeMockStrategyWithSpots(100.0, 5, 3)
+		player := NewPlayer(strategy)
+
+		spots, bet := player.Bet(0)
+
+		assert.Equal(t, 3, spots)
+		assert.Equal(t, 5, bet)
+		assert.Equal(t, 3, len(player.Games))
+		assert.Equal(t, 85.0, player.Bankroll)
+	})
+
+	t.Run("Should play fewer spots if the bankroll does not cover them", func(t *testing.T) {
+		strategy := makeMockStrategyWithSpots(12.0, 5, 3)
+		player := NewPlayer(strategy)
+
+		spots, _ := player.Bet(0)
+
+		assert.Equal(t, 2, spots)
+		assert.Equal(t, 2.0, player.Bankroll)
+	})
+
+	t.Run("Should count every spot as a game seen", func(t *testing.T) {
+		strategy := makeMockStrategyWithSpots(100.0, 1, 2)
+		player := NewPlayer(strategy)
+
+		player.Bet(0)
+
+		assert.Equal(t, 2, player.GamesSeen)
+	})
+
+	t.Run("Should count rounds apart from spots", func(t *testing.T) {
+		strategy := makeMockStrategyWithSpots(100.0, 1, 2)
+		strategy.On("GetEncodedStrategy").Return([]byte("AAA"))
+		player := NewPlayer(strategy)
+
+		player.Bet(0)
+		player.Bet(0)
+
+		assert.Equal(t, 2, player.GetStatistics().RoundsSeen)
+		assert.Equal(t, 4, player.GetStatistics().GamesSeen)
+	})
+
+	t.Run("Should play each spot with its own hand, in seat order", func(t *testing.T) {
+		strategy := makeMockStrategyWithSpots(100.0, 1, 2)
+		firstHand := Hand{NewCard(Ten, Clubs), NewCard(Six, Hearts)}
+		secondHand := Hand{NewCard(Ten, Spades), NewCard(Seven, Hearts)}
+		strategy.On("Play", firstHand, mock.Anything, mock.Anything).Return(Hit).Once()
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Stand)
+
+		player := NewPlayer(strategy)
+		shoe := makeMockShoe([]Card{NewCard(Two, Clubs)}, nil)
+
+		player.Bet(0)
+		cardsTaken := player.Play([]Hand{firstHand, secondHand}, Hand{}, shoe)
+
+		assert.Equal(t, 1, cardsTaken)
+		assert.Equal(t, Hand{NewCard(Ten, Clubs), NewCard(Six, Hearts), NewCard(Two, Clubs)}, player.Games[0].hand)
+		assert.Equal(t, secondHand, player.Games[1].hand)
+	})
+
+	t.Run("Should play split hands before moving to the next spot", func(t *testing.T) {
+		strategy := makeMockStrategyWithSpots(100.0, 1, 2)
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(SplitOrStand).Once()
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Stand)
+
+		player := NewPlayer(strategy)
+		shoe := makeMockShoe([]Card{NewCard(Two, Clubs), NewCard(Three, Clubs)}, nil)
+
+		player.Bet(0)
+		firstHand := Hand{NewCard(Eight, Clubs), NewCard(Eight, Hearts)}
+		secondHand := Hand{NewCard(Ten, Spades), NewCard(Seven, Hearts)}
+		player.Play([]Hand{firstHand, secondHand}, Hand{}, shoe)
+
+		assert.Equal(t, 3, len(player.Games))
+		assert.Equal(t, 0, player.Games[1].spot)
+		assert.Equal(t, Hand{NewCard(Eight, Hearts), NewCard(Three, Clubs)}, player.Games[1].hand)
+		assert.Equal(t, secondHand, player.Games[2].hand)
+	})
+
+	t.Run("Should resolve each spot as its own game", func(t *testing.T) {
+		strategy := makeMockStrategyWithSpots(100.0, 1, 2)
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Stand)
+
+		player := NewPlayer(strategy)
+
+		player.Bet(0)
+		dealerHand := Hand{NewCard(Ten, Diamonds), NewCard(Eight, Diamonds)}
+		winningHand := Hand{NewCard(Ten, Clubs), NewCard(Nine, Hearts)}
+		losingHand := Hand{NewCard(Ten, Spades), NewCard(Seven, Hearts)}
+		player.Play([]Hand{winningHand, losingHand}, dealerHand, &shoeMock{})
+		player.Resolve(dealerHand)
+
+		assert.Equal(t, 2, player.GamesPlayed)
+		assert.Equal(t, 1, player.GamesWon)
+		assert.Equal(t, 1, player.GamesLost)
+		assert.Equal(t, 100.0, player.Bankroll)
+	})
+}
+
 func TestPlayerPlay(t *testing.T) {
 	t.Run("Should return the number of cards dealt: regular", func(t *testing.T) {
 		strategy := makeMockStrategyWithBet(100.0, 1)
-		strategy.On("Play", mock.Anything, mock.Anything).Return(Hit).Times(5)
-		strategy.On("Play", mock.Anything, mock.Anything).Return(Stand).Once()
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Hit).Times(5)
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Stand).Once()
 
 		player := NewPlayer(strategy)
 
@@ -190,21 +380,21 @@ func TestPlayerPlay(t *testing.T) {
 			NewCard(Four, Clubs),
 		}, nil)
 
-		player.Bet()
+		player.Bet(0)
 
 		playerHand := Hand{NewCard(Three, Clubs), NewCard(Three, Hearts)}
-		cardsTaken := player.Play(playerHand, Hand{}, shoe)
+		cardsTaken := player.Play([]Hand{playerHand}, Hand{}, shoe)
 
 		assert.Equal(t, 5, cardsTaken)
 	})
 
 	t.Run("Should return the number of cards dealt: splitOrHit", func(t *testing.T) {
 		strategy := makeMockStrategyWithBet(100.0, 1)
-		strategy.On("Play", mock.Anything, mock.Anything).Return(SplitOrHit).Once()
-		strategy.On("Play", mock.Anything, mock.Anything).Return(Hit).Times(4)
-		strategy.On("Play", mock.Anything, mock.Anything).Return(Stand).Once()
-		strategy.On("Play", mock.Anything, mock.Anything).Return(Hit).Once()
-		strategy.On("Play", mock.Anything, mock.Anything).Return(Stand).Once()
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(SplitOrHit).Once()
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Hit).Times(4)
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Stand).Once()
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Hit).Once()
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Stand).Once()
 
 		player := NewPlayer(strategy)
 
@@ -218,21 +408,21 @@ func TestPlayerPlay(t *testing.T) {
 			NewCard(Ten, Diamonds),
 		}, nil)
 
-		player.Bet()
+		player.Bet(0)
 
 		playerHand := Hand{NewCard(Three, Clubs), NewCard(Three, Hearts)}
-		cardsTaken := player.Play(playerHand, Hand{}, shoe)
+		cardsTaken := player.Play([]Hand{playerHand}, Hand{}, shoe)
 
 		assert.Equal(t, 7, cardsTaken)
 	})
 
 	t.Run("Should return the number of cards dealt: splitOrStand", func(t *testing.T) {
 		strategy := makeMockStrategyWithBet(100.0, 1)
-		strategy.On("Play", mock.Anything, mock.Anything).Return(SplitOrStand).Once()
-		strategy.On("Play", mock.Anything, mock.Anything).Return(Hit).Times(4)
-		strategy.On("Play", mock.Anything, mock.Anything).Return(Stand).Once()
-		strategy.On("Play", mock.Anything, mock.Anything).Return(Hit).Once()
-		strategy.On("Play", mock.Anything, mock.Anything).Return(Stand).Once()
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(SplitOrStand).Once()
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Hit).Times(4)
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Stand).Once()
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Hit).Once()
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Stand).Once()
 
 		player := NewPlayer(strategy)
 
@@ -246,17 +436,17 @@ func TestPlayerPlay(t *testing.T) {
 			NewCard(Ten, Diamonds),
 		}, nil)
 
-		player.Bet()
+		player.Bet(0)
 
 		playerHand := Hand{NewCard(Three, Clubs), NewCard(Three, Hearts)}
-		cardsTaken := player.Play(playerHand, Hand{}, shoe)
+		cardsTaken := player.Play([]Hand{playerHand}, Hand{}, shoe)
 
 		assert.Equal(t, 7, cardsTaken)
 	})
 
 	t.Run("Should return the number of cards dealt: double", func(t *testing.T) {
 		strategy := makeMockStrategyWithBet(100.0, 1)
-		strategy.On("Play", mock.Anything, mock.Anything).Return(Double).Once()
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Double).Once()
 
 		player := NewPlayer(strategy)
 
@@ -264,18 +454,18 @@ func TestPlayerPlay(t *testing.T) {
 			NewCard(Three, Clubs),
 		}, nil)
 
-		player.Bet()
+		player.Bet(0)
 
 		playerHand := Hand{NewCard(Three, Clubs), NewCard(Three, Hearts)}
-		cardsTaken := player.Play(playerHand, Hand{}, shoe)
+		cardsTaken := player.Play([]Hand{playerHand}, Hand{}, shoe)
 
 		assert.Equal(t, 1, cardsTaken)
 	})
 
 	t.Run("Should deduct bet from bankroll if split", func(t *testing.T) {
 		strategy := makeMockStrategyWithBet(100.0, 1)
-		strategy.On("Play", mock.Anything, mock.Anything).Return(SplitOrHit).Once()
-		strategy.On("Play", mock.Anything, mock.Anything).Return(Stand).Times(2)
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(SplitOrHit).Once()
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Stand).Times(2)
 
 		player := NewPlayer(strategy)
 
@@ -284,18 +474,18 @@ func TestPlayerPlay(t *testing.T) {
 			NewCard(King, Hearts),
 		}, nil)
 
-		player.Bet()
+		player.Bet(0)
 
 		playerHand := Hand{NewCard(Three, Clubs), NewCard(Three, Hearts)}
-		player.Play(playerHand, Hand{}, shoe)
+		player.Play([]Hand{playerHand}, Hand{}, shoe)
 
 		assert.Equal(t, 98.0, player.Bankroll)
 	})
 
 	t.Run("Should not split if no funds are available, hit instead", func(t *testing.T) {
 		strategy := makeMockStrategyWithBet(1.0, 1)
-		strategy.On("Play", mock.Anything, mock.Anything).Return(SplitOrHit).Once()
-		strategy.On("Play", mock.Anything, mock.Anything).Return(Stand).Once()
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(SplitOrHit).Once()
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Stand).Once()
 
 		player := NewPlayer(strategy)
 
@@ -303,10 +493,10 @@ func TestPlayerPlay(t *testing.T) {
 			NewCard(King, Clubs),
 		}, nil)
 
-		player.Bet()
+		player.Bet(0)
 		playerHand := Hand{NewCard(King, Clubs), NewCard(King, Hearts)}
 
-		cardsTaken := player.Play(playerHand, Hand{}, shoe)
+		cardsTaken := player.Play([]Hand{playerHand}, Hand{}, shoe)
 
 		assert.Equal(t, 0.0, player.Bankroll)
 		assert.Equal(t, 1, len(player.Games))
@@ -315,24 +505,55 @@ func TestPlayerPlay(t *testing.T) {
 
 	t.Run("Should not split if no funds are available, stand instead", func(t *testing.T) {
 		strategy := makeMockStrategyWithBet(1.0, 1)
-		strategy.On("Play", mock.Anything, mock.Anything).Return(SplitOrStand).Once()
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(SplitOrStand).Once()
 
 		player := NewPlayer(strategy)
 		shoe := makeMockShoe([]Card{}, nil)
 
-		player.Bet()
+		player.Bet(0)
 		playerHand := Hand{NewCard(King, Clubs), NewCard(King, Hearts)}
 
-		cardsTaken := player.Play(playerHand, Hand{}, shoe)
+		cardsTaken := player.Play([]Hand{playerHand}, Hand{}, shoe)
 
 		assert.Equal(t, 0.0, player.Bankroll)
 		assert.Equal(t, 1, len(player.Games))
 		assert.Equal(t, 0, cardsTaken)
 	})
 
+	t.Run("Should not split a hand that is not a pair, hit instead", func(t *testing.T) {
+		strategy := makeMockStrategyWithBet(100.0, 1)
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(SplitOrHit).Once()
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Stand).Once()
+
+		player := NewPlayer(strategy)
+		shoe := makeMockShoe([]Card{NewCard(Two, Clubs)}, nil)
+
+		player.Bet(0)
+		playerHand := Hand{NewCard(Two, Hearts), NewCard(Two, Diamonds), NewCard(Three, Clubs)}
+		cardsTaken := player.Play([]Hand{playerHand}, Hand{}, shoe)
+
+		assert.Equal(t, 1, len(player.Games))
+		assert.Equal(t, 1, cardsTaken)
+	})
+
+	t.Run("Should not split a hand that is not a pair, stand instead", func(t *testing.T) {
+		strategy := makeMockStrategyWithBet(100.0, 1)
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(SplitOrStand).Once()
+
+		player := NewPlayer(strategy)
+		shoe := makeMockShoe([]Card{}, nil)
+
+		player.Bet(0)
+		playerHand := Hand{NewCard(King, Hearts), NewCard(Queen, Diamonds)}
+		cardsTaken := player.Play([]Hand{playerHand}, Hand{}, shoe)
+
+		assert.Equal(t, 1, len(player.Games))
+		assert.Equal(t, 0, cardsTaken)
+	})
+
 	t.Run("Should deduct bet from bankroll if double", func(t *testing.T) {
 		strategy := makeMockStrategyWithBet(100.0, 1)
-		strategy.On("Play", mock.Anything, mock.Anything).Return(Double).Once()
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Double).Once()
 
 		player := NewPlayer(strategy)
 
@@ -340,19 +561,19 @@ func TestPlayerPlay(t *testing.T) {
 			NewCard(Three, Clubs),
 		}, nil)
 
-		player.Bet()
+		player.Bet(0)
 		playerHand := Hand{NewCard(Three, Clubs), NewCard(Three, Hearts)}
 
-		player.Play(playerHand, Hand{}, shoe)
+		player.Play([]Hand{playerHand}, Hand{}, shoe)
 
 		assert.Equal(t, 98.0, player.Bankroll)
 	})
 
 	t.Run("Should not double if no funds are available, hit instead", func(t *testing.T) {
 		strategy := makeMockStrategyWithBet(1.0, 1)
-		strategy.On("Play", mock.Anything, mock.Anything).Return(Double).Once()
-		strategy.On("Play", mock.Anything, mock.Anything).Return(Hit).Once()
-		strategy.On("Play", mock.Anything, mock.Anything).Return(Stand).Once()
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Double).Once()
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Hit).Once()
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Stand).Once()
 
 		player := NewPlayer(strategy)
 
@@ -361,10 +582,10 @@ func TestPlayerPlay(t *testing.T) {
 			NewCard(King, Clubs),
 		}, nil)
 
-		player.Bet()
+		player.Bet(0)
 
 		playerHand := Hand{NewCard(Three, Clubs), NewCard(Three, Hearts)}
-		cardsTaken := player.Play(playerHand, Hand{}, shoe)
+		cardsTaken := player.Play([]Hand{playerHand}, Hand{}, shoe)
 
 		assert.Equal(t, 0.0, player.Bankroll)
 		assert.Equal(t, 1, player.Games[0].bet)
@@ -376,7 +597,7 @@ func TestPlayerPlay(t *testing.T) {
 		player := NewPlayer(strategy)
 		shoe := &shoeMock{}
 
-		player.Bet()
+		player.Bet(0)
 		dealerUpcard := NewCard(Ace, Clubs)
 		dealerHoleCard := NewCard(Ten, Hearts)
 		dealerHoleCard.SetHole()
@@ -384,15 +605,15 @@ func TestPlayerPlay(t *testing.T) {
 		dealerHand := Hand{dealerUpcard, dealerHoleCard}
 		playerHand := Hand{NewCard(Three, Clubs), NewCard(Three, Hearts)}
 
-		cardsTaken := player.Play(playerHand, dealerHand, shoe)
+		cardsTaken := player.Play([]Hand{playerHand}, dealerHand, shoe)
 
 		assert.Equal(t, 0, cardsTaken)
 	})
 
 	t.Run("Normal game if dealer has BJ (Ace in the hole)", func(t *testing.T) {
 		strategy := makeMockStrategyWithBet(1.0, 1)
-		strategy.On("Play", mock.Anything, mock.Anything).Return(Hit).Once()
-		strategy.On("Play", mock.Anything, mock.Anything).Return(Stand).Once()
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Hit).Once()
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Stand).Once()
 
 		player := NewPlayer(strategy)
 
@@ -400,7 +621,7 @@ func TestPlayerPlay(t *testing.T) {
 			NewCard(Three, Clubs),
 		}, nil)
 
-		player.Bet()
+		player.Bet(0)
 		dealerUpcard := NewCard(King, Clubs)
 		dealerHoleCard := NewCard(Ace, Hearts)
 		dealerHoleCard.SetHole()
@@ -408,7 +629,7 @@ func TestPlayerPlay(t *testing.T) {
 		dealerHand := Hand{dealerUpcard, dealerHoleCard}
 		playerHand := Hand{NewCard(Three, Clubs), NewCard(Three, Hearts)}
 
-		cardsTaken := player.Play(playerHand, dealerHand, shoe)
+		cardsTaken := player.Play([]Hand{playerHand}, dealerHand, shoe)
 
 		assert.Equal(t, 1, cardsTaken)
 	})
@@ -427,22 +648,141 @@ func TestPlayerPlay(t *testing.T) {
 		dealerHand := Hand{dealerUpcard, dealerHoleCard}
 		playerHand := Hand{NewCard(Eight, Clubs), NewCard(Eight, Hearts)}
 
-		shoeIndex := player.Play(playerHand, dealerHand, shoe)
+		shoeIndex := player.Play([]Hand{playerHand}, dealerHand, shoe)
 
 		assert.Equal(t, 0, shoeIndex)
 	})
+
+	t.Run("Should count doubles", func(t *testing.T) {
+		strategy := makeMockStrategyWithBet(100.0, 1)
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Double).Once()
+
+		player := NewPlayer(strategy)
+		shoe := makeMockShoe([]Card{NewCard(Three, Clubs)}, nil)
+
+		player.Bet(0)
+		player.Play([]Hand{{NewCard(Three, Clubs), NewCard(Three, Hearts)}}, Hand{}, shoe)
+
+		assert.Equal(t, 1, player.Doubles)
+		assert.Equal(t, 0, player.Splits)
+	})
+
+	t.Run("Should count splits", func(t *testing.T) {
+		strategy := makeMockStrategyWithBet(100.0, 1)
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(SplitOrHit).Once()
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Stand).Times(2)
+
+		player := NewPlayer(strategy)
+		shoe := makeMockShoe([]Card{NewCard(King, Clubs), NewCard(King, Hearts)}, nil)
+
+		player.Bet(0)
+		player.Play([]Hand{{NewCard(Three, Clubs), NewCard(Three, Hearts)}}, Hand{}, shoe)
+
+		assert.Equal(t, 1, player.Splits)
+		assert.Equal(t, 0, player.Doubles)
+	})
+}
+
+func TestPlayerSplitRules(t *testing.T) {
+	t.Run("Should tell the strategy when a hand comes from a split", func(t *testing.T) {
+		strategy := makeMockStrategyWithBet(100.0, 1)
+		strategy.On("Play", mock.Anything, mock.Anything, PlayContext{}).Return(SplitOrStand).Once()
+		strategy.On("Play", mock.Anything, mock.Anything, PlayContext{IsSplit: true}).Return(Stand).Times(2)
+
+		player := NewPlayer(strategy)
+		shoe := makeMockShoe([]Card{NewCard(King, Clubs), NewCard(King, Hearts)}, nil)
+
+		player.Bet(0)
+		playerHand := Hand{NewCard(Three, Clubs), NewCard(Three, Hearts)}
+		cardsTaken := player.Play([]Hand{playerHand}, Hand{}, shoe)
+
+		assert.Equal(t, 2, cardsTaken)
+		strategy.AssertExpectations(t)
+	})
+
+	t.Run("Should double a split hand if the table allows it", func(t *testing.T) {
+		strategy := makeMockStrategyWithBet(100.0, 1)
+		strategy.On("Play", mock.Anything, mock.Anything, PlayContext{}).Return(SplitOrStand).Once()
+		strategy.On("Play", mock.Anything, mock.Anything, PlayContext{IsSplit: true}).Return(Double).Once()
+		strategy.On("Play", mock.Anything, mock.Anything, PlayContext{IsSplit: true}).Return(Stand)
+
+		player := NewPlayer(strategy)
+		player.TakeSeat(TableOptions{DoubleAfterSplit: true})
+		shoe := makeMockShoe([]Card{NewCard(Three, Clubs), NewCard(King, Clubs), NewCard(Three, Hearts)}, nil)
+
+		player.Bet(0)
+		playerHand := Hand{NewCard(Eight, Clubs), NewCard(Eight, Hearts)}
+		cardsTaken := player.Play([]Hand{playerHand}, Hand{}, shoe)
+
+		assert.Equal(t, 3, cardsTaken)
+		assert.True(t, player.Games[0].IsDoubled)
+		assert.Equal(t, 97.0, player.Bankroll)
+	})
+
+	t.Run("Should hit instead of doubling a split hand if the table does not allow it", func(t *testing.T) {
+		strategy := makeMockStrategyWithBet(100.0, 1)
+		strategy.On("Play", mock.Anything, mock.Anything, PlayContext{}).Return(SplitOrStand).Once()
+		strategy.On("Play", mock.Anything, mock.Anything, PlayContext{IsSplit: true}).Return(Double).Once()
+		strategy.On("Play", mock.Anything, mock.Anything, PlayContext{IsSplit: true}).Return(Stand)
+
+		player := NewPlayer(strategy)
+		player.TakeSeat(TableOptions{DoubleAfterSplit: false})
+		shoe := makeMockShoe([]Card{NewCard(Three, Clubs), NewCard(King, Clubs), NewCard(Three, Hearts)}, nil)
+
+		player.Bet(0)
+		playerHand := Hand{NewCard(Eight, Clubs), NewCard(Eight, Hearts)}
+		cardsTaken := player.Play([]Hand{playerHand}, Hand{}, shoe)
+
+		assert.Equal(t, 3, cardsTaken)
+		assert.False(t, player.Games[0].IsDoubled)
+		assert.Equal(t, 98.0, player.Bankroll)
+	})
+
+	t.Run("Should not resplit past the table limit, stand instead", func(t *testing.T) {
+		strategy := makeMockStrategyWithBet(100.0, 1)
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(SplitOrStand)
+
+		player := NewPlayer(strategy)
+		player.TakeSeat(TableOptions{MaxSplitHands: 2})
+		shoe := makeMockShoe([]Card{NewCard(Three, Diamonds), NewCard(Three, Spades)}, nil)
+
+		player.Bet(0)
+		playerHand := Hand{NewCard(Three, Clubs), NewCard(Three, Hearts)}
+		cardsTaken := player.Play([]Hand{playerHand}, Hand{}, shoe)
+
+		assert.Equal(t, 2, cardsTaken)
+		assert.Equal(t, 2, len(player.Games))
+	})
+
+	t.Run("Should not resplit past the table limit, hit instead", func(t *testing.T) {
+		strategy := makeMockStrategyWithBet(100.0, 1)
+		strategy.On("Play", mock.Anything, mock.Anything, PlayContext{}).Return(SplitOrHit).Once()
+		strategy.On("Play", mock.Anything, mock.Anything, PlayContext{IsSplit: true}).Return(SplitOrHit).Once()
+		strategy.On("Play", mock.Anything, mock.Anything, PlayContext{IsSplit: true}).Return(Stand)
+
+		player := NewPlayer(strategy)
+		player.TakeSeat(TableOptions{MaxSplitHands: 2})
+		shoe := makeMockShoe([]Card{NewCard(Three, Diamonds), NewCard(King, Spades), NewCard(King, Hearts)}, nil)
+
+		player.Bet(0)
+		playerHand := Hand{NewCard(Three, Clubs), NewCard(Three, Hearts)}
+		cardsTaken := player.Play([]Hand{playerHand}, Hand{}, shoe)
+
+		assert.Equal(t, 3, cardsTaken)
+		assert.Equal(t, 2, len(player.Games))
+	})
 }
 
 func TestPlayerBankrollAfterPlay(t *testing.T) {
 	t.Run("Should credit winnings to player bankroll", func(t *testing.T) {
 		strategy := makeMockStrategyWithBet(1000.0, 1)
-		strategy.On("Play", mock.Anything, mock.Anything).Return(Stand).Once()
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Stand).Once()
 
 		player := NewPlayer(strategy)
 
 		shoe := &shoeMock{}
 
-		player.Bet()
+		player.Bet(0)
 		dealerUpcard := NewCard(Ten, Clubs)
 		dealerHoleCard := NewCard(Nine, Hearts)
 		dealerHoleCard.SetHole()
@@ -450,7 +790,7 @@ func TestPlayerBankrollAfterPlay(t *testing.T) {
 		dealerHand := Hand{dealerUpcard, dealerHoleCard}
 		playerHand := Hand{NewCard(King, Clubs), NewCard(Queen, Hearts)}
 
-		cardsTaken := player.Play(playerHand, dealerHand, shoe)
+		cardsTaken := player.Play([]Hand{playerHand}, dealerHand, shoe)
 
 		dealerHand.Reveal()
 		player.Resolve(dealerHand)
@@ -461,13 +801,13 @@ func TestPlayerBankrollAfterPlay(t *testing.T) {
 
 	t.Run("Should reflect player loss after game", func(t *testing.T) {
 		strategy := makeMockStrategyWithBet(1000.0, 1)
-		strategy.On("Play", mock.Anything, mock.Anything).Return(Stand).Once()
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Stand).Once()
 
 		player := NewPlayer(strategy)
 
 		shoe := &shoeMock{}
 
-		player.Bet()
+		player.Bet(0)
 		dealerUpcard := NewCard(Ten, Clubs)
 		dealerHoleCard := NewCard(King, Hearts)
 		dealerHoleCard.SetHole()
@@ -475,7 +815,7 @@ func TestPlayerBankrollAfterPlay(t *testing.T) {
 		dealerHand := Hand{dealerUpcard, dealerHoleCard}
 		playerHand := Hand{NewCard(Five, Clubs), NewCard(Ace, Hearts)}
 
-		cardsTaken := player.Play(playerHand, dealerHand, shoe)
+		cardsTaken := player.Play([]Hand{playerHand}, dealerHand, shoe)
 
 		dealerHand.Reveal()
 		player.Resolve(dealerHand)
@@ -486,8 +826,8 @@ func TestPlayerBankrollAfterPlay(t *testing.T) {
 
 	t.Run("Should credit winnings after spliting and winning one game", func(t *testing.T) {
 		strategy := makeMockStrategyWithBet(1000.0, 1)
-		strategy.On("Play", mock.Anything, mock.Anything).Return(SplitOrHit).Once()
-		strategy.On("Play", mock.Anything, mock.Anything).Return(Stand).Times(2)
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(SplitOrHit).Once()
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Stand).Times(2)
 
 		player := NewPlayer(strategy)
 
@@ -496,15 +836,15 @@ func TestPlayerBankrollAfterPlay(t *testing.T) {
 			NewCard(Five, Hearts),
 		}, nil)
 
-		player.Bet()
+		player.Bet(0)
 		dealerUpcard := NewCard(Ten, Clubs)
 		dealerHoleCard := NewCard(Seven, Hearts)
 		dealerHoleCard.SetHole()
 
 		dealerHand := Hand{dealerUpcard, dealerHoleCard}
-		playerHand := Hand{NewCard(Queen, Clubs), NewCard(Ace, Hearts)}
+		playerHand := Hand{NewCard(Queen, Clubs), NewCard(Queen, Hearts)}
 
-		player.Play(playerHand, dealerHand, shoe)
+		player.Play([]Hand{playerHand}, dealerHand, shoe)
 		assert.Equal(t, 2, len(player.Games))
 
 		dealerHand.Reveal()
@@ -515,8 +855,8 @@ func TestPlayerBankrollAfterPlay(t *testing.T) {
 
 	t.Run("Should credit winnings after spliting", func(t *testing.T) {
 		strategy := makeMockStrategyWithBet(1000.0, 1)
-		strategy.On("Play", mock.Anything, mock.Anything).Return(SplitOrHit).Once()
-		strategy.On("Play", mock.Anything, mock.Anything).Return(Stand).Times(2)
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(SplitOrHit).Once()
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Stand).Times(2)
 
 		player := NewPlayer(strategy)
 
@@ -525,7 +865,7 @@ func TestPlayerBankrollAfterPlay(t *testing.T) {
 			NewCard(Jack, Hearts),
 		}, nil)
 
-		player.Bet()
+		player.Bet(0)
 		dealerUpcard := NewCard(Seven, Clubs)
 		dealerHoleCard := NewCard(Ten, Hearts)
 		dealerHoleCard.SetHole()
@@ -533,7 +873,7 @@ func TestPlayerBankrollAfterPlay(t *testing.T) {
 		dealerHand := Hand{dealerUpcard, dealerHoleCard}
 		playerHand := Hand{NewCard(Queen, Clubs), NewCard(Queen, Hearts)}
 
-		player.Play(playerHand, dealerHand, shoe)
+		player.Play([]Hand{playerHand}, dealerHand, shoe)
 		assert.Equal(t, 2, len(player.Games))
 
 		dealerHand.Reveal()
@@ -544,8 +884,8 @@ func TestPlayerBankrollAfterPlay(t *testing.T) {
 
 	t.Run("Should reflect losses after spliting and losing", func(t *testing.T) {
 		strategy := makeMockStrategyWithBet(1000.0, 1)
-		strategy.On("Play", mock.Anything, mock.Anything).Return(SplitOrHit).Once()
-		strategy.On("Play", mock.Anything, mock.Anything).Return(Stand).Times(2)
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(SplitOrHit).Once()
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Stand).Times(2)
 
 		player := NewPlayer(strategy)
 
@@ -554,7 +894,7 @@ func TestPlayerBankrollAfterPlay(t *testing.T) {
 			NewCard(Six, Hearts),
 		}, nil)
 
-		player.Bet()
+		player.Bet(0)
 		dealerUpcard := NewCard(Ten, Clubs)
 		dealerHoleCard := NewCard(Ace, Hearts)
 		dealerHoleCard.SetHole()
@@ -562,7 +902,7 @@ func TestPlayerBankrollAfterPlay(t *testing.T) {
 		dealerHand := Hand{dealerUpcard, dealerHoleCard}
 		playerHand := Hand{NewCard(Ace, Clubs), NewCard(Ace, Hearts)}
 
-		player.Play(playerHand, dealerHand, shoe)
+		player.Play([]Hand{playerHand}, dealerHand, shoe)
 		assert.Equal(t, 2, len(player.Games))
 
 		dealerHand.Reveal()
@@ -573,7 +913,7 @@ func TestPlayerBankrollAfterPlay(t *testing.T) {
 
 	t.Run("Should credit winnings to player after doubling", func(t *testing.T) {
 		strategy := makeMockStrategyWithBet(1000.0, 1)
-		strategy.On("Play", mock.Anything, mock.Anything).Return(Double).Once()
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Double).Once()
 
 		player := NewPlayer(strategy)
 
@@ -581,7 +921,7 @@ func TestPlayerBankrollAfterPlay(t *testing.T) {
 			NewCard(Jack, Clubs),
 		}, nil)
 
-		player.Bet()
+		player.Bet(0)
 		dealerUpcard := NewCard(Ten, Clubs)
 		dealerHoleCard := NewCard(Seven, Hearts)
 		dealerHoleCard.SetHole()
@@ -589,7 +929,7 @@ func TestPlayerBankrollAfterPlay(t *testing.T) {
 		dealerHand := Hand{dealerUpcard, dealerHoleCard}
 		playerHand := Hand{NewCard(Six, Clubs), NewCard(Five, Diamonds)}
 
-		cardsTaken := player.Play(playerHand, dealerHand, shoe)
+		cardsTaken := player.Play([]Hand{playerHand}, dealerHand, shoe)
 
 		dealerHand = Hand{dealerUpcard, dealerHoleCard, NewCard(King, Clubs)}
 		dealerHand.Reveal()
@@ -601,7 +941,7 @@ func TestPlayerBankrollAfterPlay(t *testing.T) {
 
 	t.Run("Should reflect loss after doubling", func(t *testing.T) {
 		strategy := makeMockStrategyWithBet(1000.0, 1)
-		strategy.On("Play", mock.Anything, mock.Anything).Return(Double).Once()
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Double).Once()
 
 		player := NewPlayer(strategy)
 
@@ -609,7 +949,7 @@ func TestPlayerBankrollAfterPlay(t *testing.T) {
 			NewCard(Three, Clubs),
 		}, nil)
 
-		player.Bet()
+		player.Bet(0)
 		dealerUpcard := NewCard(Ten, Clubs)
 		dealerHoleCard := NewCard(Queen, Hearts)
 		dealerHoleCard.SetHole()
@@ -617,7 +957,7 @@ func TestPlayerBankrollAfterPlay(t *testing.T) {
 		dealerHand := Hand{dealerUpcard, dealerHoleCard}
 		playerHand := Hand{NewCard(Six, Clubs), NewCard(Five, Diamonds)}
 
-		cardsTaken := player.Play(playerHand, dealerHand, shoe)
+		cardsTaken := player.Play([]Hand{playerHand}, dealerHand, shoe)
 
 		dealerHand.Reveal()
 		player.Resolve(dealerHand)
@@ -628,13 +968,13 @@ func TestPlayerBankrollAfterPlay(t *testing.T) {
 
 	t.Run("Should credit initial bet when pushing", func(t *testing.T) {
 		strategy := makeMockStrategyWithBet(1000.0, 1)
-		strategy.On("Play", mock.Anything, mock.Anything).Return(Stand).Once()
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Stand).Once()
 
 		player := NewPlayer(strategy)
 
 		shoe := &shoeMock{}
 
-		player.Bet()
+		player.Bet(0)
 		dealerUpcard := NewCard(Ten, Clubs)
 		dealerHoleCard := NewCard(Nine, Hearts)
 		dealerHoleCard.SetHole()
@@ -642,7 +982,7 @@ func TestPlayerBankrollAfterPlay(t *testing.T) {
 		dealerHand := Hand{dealerUpcard, dealerHoleCard}
 		playerHand := Hand{NewCard(King, Clubs), NewCard(Nine, Hearts)}
 
-		cardsTaken := player.Play(playerHand, dealerHand, shoe)
+		cardsTaken := player.Play([]Hand{playerHand}, dealerHand, shoe)
 
 		dealerHand.Reveal()
 		player.Resolve(dealerHand)
@@ -653,8 +993,8 @@ func TestPlayerBankrollAfterPlay(t *testing.T) {
 
 	t.Run("Should clear games after resolving", func(t *testing.T) {
 		strategy := makeMockStrategyWithBet(1000.0, 1)
-		strategy.On("Play", mock.Anything, mock.Anything).Return(SplitOrHit).Once()
-		strategy.On("Play", mock.Anything, mock.Anything).Return(Stand).Times(2)
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(SplitOrHit).Once()
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Stand).Times(2)
 
 		player := NewPlayer(strategy)
 
@@ -663,7 +1003,7 @@ func TestPlayerBankrollAfterPlay(t *testing.T) {
 			NewCard(Six, Hearts),
 		}, nil)
 
-		player.Bet()
+		player.Bet(0)
 		dealerUpcard := NewCard(Ten, Clubs)
 		dealerHoleCard := NewCard(Nine, Hearts)
 		dealerHoleCard.SetHole()
@@ -671,7 +1011,7 @@ func TestPlayerBankrollAfterPlay(t *testing.T) {
 		dealerHand := Hand{dealerUpcard, dealerHoleCard}
 		playerHand := Hand{NewCard(Eight, Clubs), NewCard(Eight, Hearts)}
 
-		player.Play(playerHand, dealerHand, shoe)
+		player.Play([]Hand{playerHand}, dealerHand, shoe)
 
 		dealerHand.Reveal()
 		player.Resolve(dealerHand)
@@ -684,20 +1024,20 @@ func TestPlayerBankrollAfterPlay(t *testing.T) {
 
 		player := NewPlayer(strategy)
 
-		player.Bet()
+		player.Bet(0)
 
 		assert.Equal(t, 1, player.GamesSeen)
 	})
 
 	t.Run("Should increase the games played counter", func(t *testing.T) {
 		strategy := makeMockStrategyWithBet(1000.0, 1)
-		strategy.On("Play", mock.Anything, mock.Anything).Return(Stand).Once()
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Stand).Once()
 
 		player := NewPlayer(strategy)
 
 		shoe := &shoeMock{}
 
-		player.Bet()
+		player.Bet(0)
 		dealerUpcard := NewCard(Ten, Clubs)
 		dealerHoleCard := NewCard(Nine, Hearts)
 		dealerHoleCard.SetHole()
@@ -705,7 +1045,7 @@ func TestPlayerBankrollAfterPlay(t *testing.T) {
 		dealerHand := Hand{dealerUpcard, dealerHoleCard}
 		playerHand := Hand{NewCard(King, Clubs), NewCard(Queen, Hearts)}
 
-		player.Play(playerHand, dealerHand, shoe)
+		player.Play([]Hand{playerHand}, dealerHand, shoe)
 
 		dealerHand.Reveal()
 		player.Resolve(dealerHand)
@@ -715,13 +1055,13 @@ func TestPlayerBankrollAfterPlay(t *testing.T) {
 
 	t.Run("Should increase the gamesWon counter", func(t *testing.T) {
 		strategy := makeMockStrategyWithBet(1000.0, 1)
-		strategy.On("Play", mock.Anything, mock.Anything).Return(Stand).Once()
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Stand).Once()
 
 		player := NewPlayer(strategy)
 
 		shoe := &shoeMock{}
 
-		player.Bet()
+		player.Bet(0)
 		dealerUpcard := NewCard(Ten, Clubs)
 		dealerHoleCard := NewCard(Nine, Hearts)
 		dealerHoleCard.SetHole()
@@ -729,7 +1069,7 @@ func TestPlayerBankrollAfterPlay(t *testing.T) {
 		dealerHand := Hand{dealerUpcard, dealerHoleCard}
 		playerHand := Hand{NewCard(King, Clubs), NewCard(Queen, Hearts)}
 
-		player.Play(playerHand, dealerHand, shoe)
+		player.Play([]Hand{playerHand}, dealerHand, shoe)
 
 		dealerHand.Reveal()
 		player.Resolve(dealerHand)
@@ -739,13 +1079,13 @@ func TestPlayerBankrollAfterPlay(t *testing.T) {
 
 	t.Run("Should increase the gamesLost counter", func(t *testing.T) {
 		strategy := makeMockStrategyWithBet(1000.0, 1)
-		strategy.On("Play", mock.Anything, mock.Anything).Return(Stand).Once()
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Stand).Once()
 
 		player := NewPlayer(strategy)
 
 		shoe := &shoeMock{}
 
-		player.Bet()
+		player.Bet(0)
 		dealerUpcard := NewCard(Ten, Clubs)
 		dealerHoleCard := NewCard(Nine, Hearts)
 		dealerHoleCard.SetHole()
@@ -753,7 +1093,7 @@ func TestPlayerBankrollAfterPlay(t *testing.T) {
 		dealerHand := Hand{dealerUpcard, dealerHoleCard}
 		playerHand := Hand{NewCard(King, Clubs), NewCard(Seven, Hearts)}
 
-		player.Play(playerHand, dealerHand, shoe)
+		player.Play([]Hand{playerHand}, dealerHand, shoe)
 
 		dealerHand.Reveal()
 		player.Resolve(dealerHand)
@@ -763,13 +1103,13 @@ func TestPlayerBankrollAfterPlay(t *testing.T) {
 
 	t.Run("Should increase the gamesPushed counter", func(t *testing.T) {
 		strategy := makeMockStrategyWithBet(1000.0, 1)
-		strategy.On("Play", mock.Anything, mock.Anything).Return(Stand).Once()
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Stand).Once()
 
 		player := NewPlayer(strategy)
 
 		shoe := &shoeMock{}
 
-		player.Bet()
+		player.Bet(0)
 		dealerUpcard := NewCard(King, Clubs)
 		dealerHoleCard := NewCard(King, Hearts)
 		dealerHoleCard.SetHole()
@@ -777,7 +1117,7 @@ func TestPlayerBankrollAfterPlay(t *testing.T) {
 		dealerHand := Hand{dealerUpcard, dealerHoleCard}
 		playerHand := Hand{NewCard(Queen, Clubs), NewCard(Queen, Hearts)}
 
-		player.Play(playerHand, dealerHand, shoe)
+		player.Play([]Hand{playerHand}, dealerHand, shoe)
 
 		dealerHand.Reveal()
 		player.Resolve(dealerHand)
@@ -787,8 +1127,8 @@ func TestPlayerBankrollAfterPlay(t *testing.T) {
 
 	t.Run("Should increase counters by 1 when splitting", func(t *testing.T) {
 		strategy := makeMockStrategyWithBet(1000.0, 1)
-		strategy.On("Play", mock.Anything, mock.Anything).Return(SplitOrHit).Once()
-		strategy.On("Play", mock.Anything, mock.Anything).Return(Stand).Times(2)
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(SplitOrHit).Once()
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Stand).Times(2)
 
 		player := NewPlayer(strategy)
 
@@ -797,7 +1137,7 @@ func TestPlayerBankrollAfterPlay(t *testing.T) {
 			NewCard(Queen, Hearts),
 		}, nil)
 
-		player.Bet()
+		player.Bet(0)
 		dealerUpcard := NewCard(Seven, Clubs)
 		dealerHoleCard := NewCard(Ten, Hearts)
 		dealerHoleCard.SetHole()
@@ -805,7 +1145,7 @@ func TestPlayerBankrollAfterPlay(t *testing.T) {
 		dealerHand := Hand{dealerUpcard, dealerHoleCard}
 		playerHand := Hand{NewCard(Ace, Clubs), NewCard(Ace, Hearts)}
 
-		player.Play(playerHand, dealerHand, shoe)
+		player.Play([]Hand{playerHand}, dealerHand, shoe)
 		assert.Equal(t, 2, len(player.Games))
 
 		dealerHand.Reveal()
@@ -891,6 +1231,201 @@ func TestPlayerGetStatistics(t *testing.T) {
 
 		assert.Equal(t, want, got)
 	})
+
+	t.Run("Should return the doubles and splits", func(t *testing.T) {
+		player.Doubles = 3
+		player.Splits = 2
+		statistics := player.GetStatistics()
+
+		assert.Equal(t, 3, statistics.Doubles)
+		assert.Equal(t, 2, statistics.Splits)
+	})
+
+	t.Run("Should return the expected value and standard deviation per hand", func(t *testing.T) {
+		player.NetWinnings = 20
+		player.NetWinningsSquared = 1040
+		statistics := player.GetStatistics()
+
+		assert.Equal(t, 2.0, statistics.ExpectedValue)
+		assert.Equal(t, 10.0, statistics.StandardDeviation)
+	})
+
+	t.Run("Should estimate the risk of ruin from the edge, spread and bankroll", func(t *testing.T) {
+		player.Bankroll = 100
+		player.NetWinnings = 20
+		player.NetWinningsSquared = 1040
+
+		assert.InDelta(t, math.Exp(-4), player.GetStatistics().RiskOfRuin, 1e-9)
+	})
+
+	t.Run("Should be certain of ruin without an edge", func(t *testing.T) {
+		player.NetWinnings = -10
+		player.NetWinningsSquared = 1000
+
+		assert.Equal(t, 1.0, player.GetStatistics().RiskOfRuin)
+	})
+
+	t.Run("Should report no spread nor ruin before playing", func(t *testing.T) {
+		fresh := NewPlayer(strategy)
+		statistics := fresh.GetStatistics()
+
+		assert.Equal(t, 0.0, statistics.ExpectedValue)
+		assert.Equal(t, 0.0, statistics.StandardDeviation)
+		assert.Equal(t, 0.0, statistics.RiskOfRuin)
+	})
+
+	t.Run("Should sum what every hand won or lost", func(t *testing.T) {
+		fresh := NewPlayer(strategy)
+		fresh.updateStatistics(10, 25)
+		fresh.updateStatistics(10, 0)
+
+		assert.Equal(t, 5.0, fresh.NetWinnings)
+		assert.Equal(t, 325.0, fresh.NetWinningsSquared)
+	})
+}
+
+func TestPlayerSideBets(t *testing.T) {
+	dealSideBetRound := func(player *Player, trueCount int, playerHand Hand, dealerHand Hand) {
+		player.Bet(0)
+		player.PlaceSideBets(trueCount)
+		player.Play([]Hand{playerHand}, dealerHand, &shoeMock{})
+		player.Resolve(dealerHand)
+	}
+
+	t.Run("Should not place side bets without triggers", func(t *testing.T) {
+		strategy := makeMockStrategyWithBet(100.0, 1)
+		player := NewPlayer(strategy)
+
+		player.Bet(0)
+		player.PlaceSideBets(10)
+
+		assert.Equal(t, 99.0, player.Bankroll)
+	})
+
+	t.Run("Should place side bets when the count reaches the trigger", func(t *testing.T) {
+		strategy := makeMockStrategyWithBet(100.0, 1)
+		player := NewPlayer(strategy)
+		player.SetSideBets([]SideBetTrigger{
+			{Kind: PerfectPairs, Bet: 5, MinTrueCount: 2},
+			{Kind: TwentyOnePlusThree, Bet: 5, MinTrueCount: 4},
+		})
+
+		player.Bet(0)
+		player.PlaceSideBets(2)
+
+		assert.Equal(t, 94.0, player.Bankroll)
+	})
+
+	t.Run("Should not place side bets without a main bet", func(t *testing.T) {
+		strategy := makeMockStrategyWithBet(0.0, 1)
+		player := NewPlayer(strategy)
+		player.SetSideBets([]SideBetTrigger{{Kind: PerfectPairs, Bet: 5}})
+		player.Bankroll = 0
+
+		player.Bet(0)
+		player.Bankroll = 10
+		player.PlaceSideBets(0)
+
+		assert.Equal(t, 10.0, player.Bankroll)
+	})
+
+	t.Run("Should credit side bet winnings", func(t *testing.T) {
+		strategy := makeMockStrategyWithBet(100.0, 1)
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Stand)
+		player := NewPlayer(strategy)
+		player.SetSideBets([]SideBetTrigger{{Kind: PerfectPairs, Bet: 2}})
+
+		playerHand := Hand{NewCard(Eight, Hearts), NewCard(Eight, Spades)}
+		dealerHand := Hand{NewCard(Ten, Clubs), NewCard(King, Hearts)}
+		dealSideBetRound(player, 0, playerHand, dealerHand)
+
+		// main bet lost (-1), mixed pair paid 6 to 1 (+12)
+		assert.Equal(t, 111.0, player.Bankroll)
+		assert.Equal(t, SideBetStatistics{Placed: 1, Won: 1, Wagered: 2, Returned: 14}, player.SideBets[PerfectPairs])
+	})
+
+	t.Run("Should pay side bets with the table's pay tables", func(t *testing.T) {
+		strategy := makeMockStrategyWithBet(100.0, 1)
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Stand)
+		player := NewPlayer(strategy)
+		player.SetSideBets([]SideBetTrigger{{Kind: PerfectPairs, Bet: 2}})
+		player.TakeSeat(TableOptions{
+			SideBetPayTables: SideBetPayTables{PerfectPairs: {MixedPair: 5}},
+		})
+
+		playerHand := Hand{NewCard(Eight, Hearts), NewCard(Eight, Spades)}
+		dealerHand := Hand{NewCard(Ten, Clubs), NewCard(King, Hearts)}
+		dealSideBetRound(player, 0, playerHand, dealerHand)
+
+		assert.Equal(t, 109.0, player.Bankroll)
+	})
+
+	t.Run("Should record lost side bets", func(t *testing.T) {
+		strategy := makeMockStrategyWithBet(100.0, 1)
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Stand)
+		player := NewPlayer(strategy)
+		player.SetSideBets([]SideBetTrigger{{Kind: LuckyLadies, Bet: 2}})
+
+		playerHand := Hand{NewCard(Eight, Clubs), NewCard(Nine, Spades)}
+		dealerHand := Hand{NewCard(Ten, Clubs), NewCard(Seven, Hearts)}
+		dealSideBetRound(player, 0, playerHand, dealerHand)
+
+		assert.Equal(t, 98.0, player.Bankroll)
+		assert.Equal(t, SideBetStatistics{Placed: 1, Won: 0, Wagered: 2, Returned: 0}, player.SideBets[LuckyLadies])
+	})
+
+	t.Run("Should keep side bets out of the main game counters", func(t *testing.T) {
+		strategy := makeMockStrategyWithBet(100.0, 1)
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Stand)
+		player := NewPlayer(strategy)
+		player.SetSideBets([]SideBetTrigger{{Kind: PerfectPairs, Bet: 2}})
+
+		playerHand := Hand{NewCard(Eight, Hearts), NewCard(Eight, Spades)}
+		dealerHand := Hand{NewCard(Ten, Clubs), NewCard(King, Hearts)}
+		dealSideBetRound(player, 0, playerHand, dealerHand)
+
+		assert.Equal(t, 1, player.GamesPlayed)
+		assert.Equal(t, 1, player.GamesLost)
+	})
+
+	t.Run("Should place and settle side bets on every spot with its own cards", func(t *testing.T) {
+		strategy := makeMockStrategyWithSpots(100.0, 1, 2)
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Stand)
+		player := NewPlayer(strategy)
+		player.SetSideBets([]SideBetTrigger{{Kind: PerfectPairs, Bet: 2}})
+
+		pair := Hand{NewCard(Eight, Hearts), NewCard(Eight, Spades)}
+		noPair := Hand{NewCard(Eight, Clubs), NewCard(Nine, Spades)}
+		dealerHand := Hand{NewCard(Ten, Clubs), NewCard(King, Hearts)}
+
+		player.Bet(0)
+		player.PlaceSideBets(0)
+		assert.Equal(t, 94.0, player.Bankroll)
+
+		player.Play([]Hand{noPair, pair}, dealerHand, &shoeMock{})
+		player.Resolve(dealerHand)
+
+		// both main bets lost, the pair on the second spot paid 6 to 1
+		assert.Equal(t, 108.0, player.Bankroll)
+		assert.Equal(t, SideBetStatistics{Placed: 2, Won: 1, Wagered: 4, Returned: 14}, player.SideBets[PerfectPairs])
+	})
+
+	t.Run("Should report side bets in the statistics", func(t *testing.T) {
+		strategy := makeMockStrategyWithBet(100.0, 1)
+		strategy.On("Play", mock.Anything, mock.Anything, mock.Anything).Return(Stand)
+		strategy.On("GetEncodedStrategy").Return([]byte("AAA"))
+		player := NewPlayer(strategy)
+		player.SetSideBets([]SideBetTrigger{{Kind: PerfectPairs, Bet: 2}})
+
+		playerHand := Hand{NewCard(Eight, Hearts), NewCard(Eight, Spades)}
+		dealerHand := Hand{NewCard(Ten, Clubs), NewCard(King, Hearts)}
+		dealSideBetRound(player, 0, playerHand, dealerHand)
+
+		got := player.GetStatistics().SideBets[PerfectPairs]
+		want := SideBetStatistics{Placed: 1, Won: 1, Wagered: 2, Returned: 14}
+
+		assert.Equal(t, want, got)
+	})
 }
 
 // func TestGameRestrictions(t *testing.T) {
@@ -13,6 +13,8 @@ type Shoeish interface {
 	SetPenetration(deckPercentage float64)
 	NeedsReshuffle() bool
 	PeekAtIndex(index int) (Card, error)
+	RunningCount() int
+	TrueCount() int
 }
 
 type Shoe struct {
@@ -21,6 +23,7 @@ type Shoe struct {
 	cursor           int
 	penetrationIndex int
 	needsReshuffle   bool
+	runningCount     int
 }
 
 type CursorOutOfBoundsError struct {
@@ -81,6 +84,7 @@ func (shoe *Shoe) Shuffle() {
 
 	shoe.needsReshuffle = false
 	shoe.cursor = 0
+	shoe.runningCount = 0
 }
 
 func (shoe *Shoe) Peek(count int) []Card {
@@ -107,6 +111,10 @@ func (shoe *Shoe) AdvanceCursor(offset int) (int, error) {
 		return shoe.cursor, &CursorOutOfBoundsError{shoe.cursor, offset, len(shoe.cards)}
 	}
 
+	for _, card := range shoe.cards[shoe.cursor:advanceTo] {
+		shoe.runningCount += card.CountValue()
+	}
+
 	shoe.cursor = advanceTo
 
 	if shoe.cursor >= shoe.penetrationIndex {
@@ -124,6 +132,19 @@ func (shoe *Shoe) NeedsReshuffle() bool {
 	return shoe.needsReshuffle
 }
 
+func (shoe *Shoe) RunningCount() int {
+	return shoe.runningCount
+}
+
+func (shoe *Shoe) TrueCount() int {
+	remainingDecks := float64(len(shoe.cards)-shoe.cursor) / 52.0
+	if remainingDecks < 1 {
+		return shoe.runningCount
+	}
+
+	return int(float64(shoe.runningCount) / remainingDecks)
+}
+
 // Private methods
 
 func (shoe *Shoe) build() {
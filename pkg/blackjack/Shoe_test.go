@@ -157,3 +157,70 @@ func TestPenetration(t *testing.T) {
 		assert.False(t, shoe.NeedsReshuffle())
 	})
 }
+
+func TestShoeCount(t *testing.T) {
+	makeShoe := func(cards []Card) *Shoe {
+		shoe := NewShoe(1)
+		shoe.cards = cards
+		return shoe
+	}
+
+	t.Run("Should start with a zero count", func(t *testing.T) {
+		shoe := NewShoe(1)
+		assert.Equal(t, 0, shoe.RunningCount())
+		assert.Equal(t, 0, shoe.TrueCount())
+	})
+
+	t.Run("Should count the cards the cursor advanced past", func(t *testing.T) {
+		shoe := makeShoe([]Card{
+			NewCard(Two, Hearts),
+			NewCard(Five, Clubs),
+			NewCard(Eight, Spades),
+			NewCard(King, Diamonds),
+			NewCard(Three, Hearts),
+		})
+
+		shoe.AdvanceCursor(4)
+
+		assert.Equal(t, 1, shoe.RunningCount())
+	})
+
+	t.Run("Should not count cards if the cursor could not advance", func(t *testing.T) {
+		shoe := makeShoe([]Card{NewCard(Two, Hearts)})
+
+		shoe.AdvanceCursor(2)
+
+		assert.Equal(t, 0, shoe.RunningCount())
+	})
+
+	t.Run("Should reset the count when shuffling", func(t *testing.T) {
+		shoe := makeShoe([]Card{NewCard(Two, Hearts), NewCard(Three, Hearts)})
+		shoe.AdvanceCursor(2)
+
+		shoe.Shuffle()
+
+		assert.Equal(t, 0, shoe.RunningCount())
+	})
+
+	t.Run("Should divide the running count by the remaining decks", func(t *testing.T) {
+		shoe := NewShoe(4)
+		lowCards := []Card{}
+		for i := 0; i < 26; i++ {
+			lowCards = append(lowCards, NewCard(Two, Hearts))
+		}
+		copy(shoe.cards, lowCards)
+
+		shoe.AdvanceCursor(26)
+
+		// 26 cards seen, 182 cards (3.5 decks) left
+		assert.Equal(t, 26, shoe.RunningCount())
+		assert.Equal(t, 7, shoe.TrueCount())
+	})
+
+	t.Run("Should use the running count in the last deck", func(t *testing.T) {
+		shoe := makeShoe([]Card{NewCard(Two, Hearts), NewCard(Three, Hearts), NewCard(Four, Hearts)})
+		shoe.AdvanceCursor(2)
+
+		assert.Equal(t, 2, shoe.TrueCount())
+	})
+}
@@ -0,0 +1,219 @@
+package blackjack
+
+import (
+	"sort"
+
+	"github.com/samber/lo"
+)
+
+type SideBetKind string
+
+const (
+	TwentyOnePlusThree SideBetKind = "21+3"
+	PerfectPairs       SideBetKind = "PP"
+	LuckyLadies        SideBetKind = "LL"
+)
+
+var SIDE_BETS = [...]SideBetKind{TwentyOnePlusThree, PerfectPairs, LuckyLadies}
+
+type SideBetOutcome string
+
+const (
+	NoSideBetOutcome SideBetOutcome = ""
+
+	// 21+3: player's first two cards and the dealer upcard as a poker hand
+	SuitedTrips   SideBetOutcome = "suited-trips"
+	StraightFlush SideBetOutcome = "straight-flush"
+	ThreeOfAKind  SideBetOutcome = "three-of-a-kind"
+	Straight      SideBetOutcome = "straight"
+	Flush         SideBetOutcome = "flush"
+
+	// Perfect Pairs: player's first two cards
+	PerfectPair SideBetOutcome = "perfect-pair"
+	ColoredPair SideBetOutcome = "colored-pair"
+	MixedPair   SideBetOutcome = "mixed-pair"
+
+	// Lucky Ladies: player's first two cards totaling 20
+	QueenOfHeartsPairWithDealerBlackjack SideBetOutcome = "queen-of-hearts-pair-dealer-blackjack"
+	QueenOfHeartsPair                    SideBetOutcome = "queen-of-hearts-pair"
+	MatchedTwenty                        SideBetOutcome = "matched-twenty"
+	SuitedTwenty                         SideBetOutcome = "suited-twenty"
+	AnyTwenty                            SideBetOutcome = "any-twenty"
+)
+
+// Odds paid for each outcome, as in X to 1
+type SideBetPayTable map[SideBetOutcome]float64
+
+type SideBetPayTables map[SideBetKind]SideBetPayTable
+
+type SideBetTrigger struct {
+	Kind         SideBetKind
+	Bet          int
+	MinTrueCount int
+}
+
+type SideBetStatistics struct {
+	Placed   int
+	Won      int
+	Wagered  float64
+	Returned float64
+}
+
+// Factory
+
+func DefaultSideBetPayTables() SideBetPayTables {
+	return SideBetPayTables{
+		TwentyOnePlusThree: {
+			SuitedTrips:   100,
+			StraightFlush: 40,
+			ThreeOfAKind:  30,
+			Straight:      10,
+			Flush:         5,
+		},
+		PerfectPairs: {
+			PerfectPair: 25,
+			ColoredPair: 12,
+			MixedPair:   6,
+		},
+		LuckyLadies: {
+			QueenOfHeartsPairWithDealerBlackjack: 1000,
+			QueenOfHeartsPair:                    200,
+			MatchedTwenty:                        25,
+			SuitedTwenty:                         10,
+			AnyTwenty:                            4,
+		},
+	}
+}
+
+// Public methods
+
+// Returns the amount given back for the wager (bet included), or 0 if the bet lost
+func (payTable SideBetPayTable) Payout(outcome SideBetOutcome, bet int) float64 {
+	odds, ok := payTable[outcome]
+	if !ok || outcome == NoSideBetOutcome {
+		return 0
+	}
+
+	return float64(bet) * (odds + 1)
+}
+
+// Static methods
+
+func ResolveSideBet(kind SideBetKind, playerHand Hand, dealerHand Hand) SideBetOutcome {
+	if len(playerHand) < 2 || len(dealerHand) < 1 {
+		return NoSideBetOutcome
+	}
+
+	switch kind {
+	case TwentyOnePlusThree:
+		return resolveTwentyOnePlusThree(playerHand[0], playerHand[1], dealerHand[0])
+	case PerfectPairs:
+		return resolvePerfectPairs(playerHand[0], playerHand[1])
+	case LuckyLadies:
+		return resolveLuckyLadies(playerHand[0], playerHand[1], dealerHand)
+	default:
+		return NoSideBetOutcome
+	}
+}
+
+// Helper methods
+
+func resolveTwentyOnePlusThree(first Card, second Card, upcard Card) SideBetOutcome {
+	cards := []Card{first, second, upcard}
+
+	isFlush := lo.EveryBy(cards, func(card Card) bool { return card.suit == first.suit })
+	isTrips := lo.EveryBy(cards, func(card Card) bool { return card.rank == first.rank })
+	isStraight := isStraight(cards)
+
+	switch {
+	case isTrips && isFlush:
+		return SuitedTrips
+	case isStraight && isFlush:
+		return StraightFlush
+	case isTrips:
+		return ThreeOfAKind
+	case isStraight:
+		return Straight
+	case isFlush:
+		return Flush
+	default:
+		return NoSideBetOutcome
+	}
+}
+
+func resolvePerfectPairs(first Card, second Card) SideBetOutcome {
+	if first.rank != second.rank {
+		return NoSideBetOutcome
+	}
+
+	if first.suit == second.suit {
+		return PerfectPair
+	}
+
+	if isRed(first) == isRed(second) {
+		return ColoredPair
+	}
+
+	return MixedPair
+}
+
+func resolveLuckyLadies(first Card, second Card, dealerHand Hand) SideBetOutcome {
+	firstTwo := Hand{first, second}
+	score, _ := firstTwo.Score()
+	if score.High != 20 {
+		return NoSideBetOutcome
+	}
+
+	isQueenOfHearts := func(card Card) bool { return card.rank == Queen && card.suit == Hearts }
+	if isQueenOfHearts(first) && isQueenOfHearts(second) {
+		dealerFirstTwo := Hand{dealerHand[0]}
+		if len(dealerHand) > 1 {
+			dealerFirstTwo = append(dealerFirstTwo, dealerHand[1])
+		}
+
+		if dealerFirstTwo.IsBlackjack() {
+			return QueenOfHeartsPairWithDealerBlackjack
+		}
+		return QueenOfHeartsPair
+	}
+
+	if first.suit == second.suit && first.rank == second.rank {
+		return MatchedTwenty
+	}
+
+	if first.suit == second.suit {
+		return SuitedTwenty
+	}
+
+	return AnyTwenty
+}
+
+func isRed(card Card) bool {
+	return card.suit == Hearts || card.suit == Diamonds
+}
+
+func isStraight(cards []Card) bool {
+	ranks := lo.Map(cards, func(card Card, _ int) int { return int(card.rank) })
+	sort.Ints(ranks)
+
+	isRun := func(ranks []int) bool {
+		for i := 1; i < len(ranks); i++ {
+			if ranks[i] != ranks[i-1]+1 {
+				return false
+			}
+		}
+		return true
+	}
+
+	if isRun(ranks) {
+		return true
+	}
+
+	// Aces can also play high, as in Q-K-A
+	if ranks[0] == Ace {
+		highAce := append(ranks[1:], King+1)
+		return isRun(highAce)
+	}
+
+	return false
+}
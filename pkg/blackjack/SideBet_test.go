@@ -0,0 +1,177 @@
+package blackjack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSideBetResolution(t *testing.T) {
+	testCases := []struct {
+		desc       string
+		kind       SideBetKind
+		playerHand Hand
+		dealerHand Hand
+		want       SideBetOutcome
+	}{
+		{
+			desc:       "21+3: suited trips",
+			kind:       TwentyOnePlusThree,
+			playerHand: Hand{NewCard(Seven, Hearts), NewCard(Seven, Hearts)},
+			dealerHand: Hand{NewCard(Seven, Hearts), NewCard(King, Clubs)},
+			want:       SuitedTrips,
+		},
+		{
+			desc:       "21+3: straight flush",
+			kind:       TwentyOnePlusThree,
+			playerHand: Hand{NewCard(Nine, Spades), NewCard(Jack, Spades)},
+			dealerHand: Hand{NewCard(Ten, Spades), NewCard(King, Clubs)},
+			want:       StraightFlush,
+		},
+		{
+			desc:       "21+3: three of a kind",
+			kind:       TwentyOnePlusThree,
+			playerHand: Hand{NewCard(Four, Spades), NewCard(Four, Hearts)},
+			dealerHand: Hand{NewCard(Four, Clubs), NewCard(King, Clubs)},
+			want:       ThreeOfAKind,
+		},
+		{
+			desc:       "21+3: straight with a low ace",
+			kind:       TwentyOnePlusThree,
+			playerHand: Hand{NewCard(Ace, Spades), NewCard(Two, Hearts)},
+			dealerHand: Hand{NewCard(Three, Clubs), NewCard(King, Clubs)},
+			want:       Straight,
+		},
+		{
+			desc:       "21+3: straight with a high ace",
+			kind:       TwentyOnePlusThree,
+			playerHand: Hand{NewCard(Queen, Spades), NewCard(Ace, Hearts)},
+			dealerHand: Hand{NewCard(King, Clubs), NewCard(Two, Clubs)},
+			want:       Straight,
+		},
+		{
+			desc:       "21+3: flush",
+			kind:       TwentyOnePlusThree,
+			playerHand: Hand{NewCard(Two, Diamonds), NewCard(Nine, Diamonds)},
+			dealerHand: Hand{NewCard(King, Diamonds), NewCard(Two, Clubs)},
+			want:       Flush,
+		},
+		{
+			desc:       "21+3: nothing",
+			kind:       TwentyOnePlusThree,
+			playerHand: Hand{NewCard(Two, Diamonds), NewCard(Nine, Hearts)},
+			dealerHand: Hand{NewCard(King, Diamonds), NewCard(Two, Clubs)},
+			want:       NoSideBetOutcome,
+		},
+		{
+			desc:       "Perfect Pairs: perfect pair",
+			kind:       PerfectPairs,
+			playerHand: Hand{NewCard(Eight, Clubs), NewCard(Eight, Clubs)},
+			dealerHand: Hand{NewCard(King, Diamonds)},
+			want:       PerfectPair,
+		},
+		{
+			desc:       "Perfect Pairs: colored pair",
+			kind:       PerfectPairs,
+			playerHand: Hand{NewCard(Eight, Hearts), NewCard(Eight, Diamonds)},
+			dealerHand: Hand{NewCard(King, Diamonds)},
+			want:       ColoredPair,
+		},
+		{
+			desc:       "Perfect Pairs: mixed pair",
+			kind:       PerfectPairs,
+			playerHand: Hand{NewCard(Eight, Hearts), NewCard(Eight, Spades)},
+			dealerHand: Hand{NewCard(King, Diamonds)},
+			want:       MixedPair,
+		},
+		{
+			desc:       "Perfect Pairs: ten-valued cards of different ranks are not a pair",
+			kind:       PerfectPairs,
+			playerHand: Hand{NewCard(King, Hearts), NewCard(Queen, Hearts)},
+			dealerHand: Hand{NewCard(King, Diamonds)},
+			want:       NoSideBetOutcome,
+		},
+		{
+			desc:       "Lucky Ladies: queen of hearts pair with dealer blackjack",
+			kind:       LuckyLadies,
+			playerHand: Hand{NewCard(Queen, Hearts), NewCard(Queen, Hearts)},
+			dealerHand: Hand{NewCard(Ace, Spades), NewCard(King, Clubs)},
+			want:       QueenOfHeartsPairWithDealerBlackjack,
+		},
+		{
+			desc:       "Lucky Ladies: queen of hearts pair",
+			kind:       LuckyLadies,
+			playerHand: Hand{NewCard(Queen, Hearts), NewCard(Queen, Hearts)},
+			dealerHand: Hand{NewCard(Nine, Spades), NewCard(King, Clubs)},
+			want:       QueenOfHeartsPair,
+		},
+		{
+			desc:       "Lucky Ladies: matched twenty",
+			kind:       LuckyLadies,
+			playerHand: Hand{NewCard(King, Spades), NewCard(King, Spades)},
+			dealerHand: Hand{NewCard(Nine, Spades), NewCard(King, Clubs)},
+			want:       MatchedTwenty,
+		},
+		{
+			desc:       "Lucky Ladies: suited twenty",
+			kind:       LuckyLadies,
+			playerHand: Hand{NewCard(Ace, Clubs), NewCard(Nine, Clubs)},
+			dealerHand: Hand{NewCard(Nine, Spades), NewCard(King, Clubs)},
+			want:       SuitedTwenty,
+		},
+		{
+			desc:       "Lucky Ladies: any twenty",
+			kind:       LuckyLadies,
+			playerHand: Hand{NewCard(Jack, Clubs), NewCard(Ten, Hearts)},
+			dealerHand: Hand{NewCard(Nine, Spades), NewCard(King, Clubs)},
+			want:       AnyTwenty,
+		},
+		{
+			desc:       "Lucky Ladies: not twenty",
+			kind:       LuckyLadies,
+			playerHand: Hand{NewCard(Jack, Clubs), NewCard(Nine, Hearts)},
+			dealerHand: Hand{NewCard(Nine, Spades), NewCard(King, Clubs)},
+			want:       NoSideBetOutcome,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.desc, func(t *testing.T) {
+			got := ResolveSideBet(testCase.kind, testCase.playerHand, testCase.dealerHand)
+			assert.Equal(t, testCase.want, got)
+		})
+	}
+
+	t.Run("Should only look at the first two player cards", func(t *testing.T) {
+		playerHand := Hand{NewCard(Eight, Clubs), NewCard(Eight, Clubs), NewCard(Two, Hearts)}
+		dealerHand := Hand{NewCard(King, Diamonds)}
+
+		got := ResolveSideBet(PerfectPairs, playerHand, dealerHand)
+		assert.Equal(t, PerfectPair, got)
+	})
+}
+
+func TestSideBetPayout(t *testing.T) {
+	payTable := DefaultSideBetPayTables()[PerfectPairs]
+
+	t.Run("Should return the bet plus the winnings", func(t *testing.T) {
+		got := payTable.Payout(MixedPair, 5)
+		want := 35.0
+
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("Should return 0 when there is no outcome", func(t *testing.T) {
+		got := payTable.Payout(NoSideBetOutcome, 5)
+		want := 0.0
+
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("Should return 0 when the outcome is not in the pay table", func(t *testing.T) {
+		got := payTable.Payout(Flush, 5)
+		want := 0.0
+
+		assert.Equal(t, want, got)
+	})
+}
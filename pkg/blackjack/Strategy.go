@@ -1,35 +1,76 @@
 package blackjack
 
 import (
+	"bytes"
 	"fmt"
 	"strconv"
+	"strings"
+
+	"github.com/samber/lo"
 )
 
 type Strategyish interface {
-	Play(playerHand Hand, dealerHand Hand) PlayerAction
+	Play(playerHand Hand, dealerHand Hand, context PlayContext) PlayerAction
 	Bet() int
+	Spots(trueCount int) int
 	GetInitialBankroll() float64
 	GetEncodedStrategy() []byte
 }
 
+type PlayContext struct {
+	IsSplit bool
+}
+
 type Strategy struct {
 	pairMap         map[PlayerHand]map[DealerHand]PlayerAction
 	softMap         map[PlayerHand]map[DealerHand]PlayerAction
 	hardMap         map[PlayerHand]map[DealerHand]PlayerAction
+	splitPairMap    map[PlayerHand]map[DealerHand]PlayerAction
+	splitSoftMap    map[PlayerHand]map[DealerHand]PlayerAction
+	splitHardMap    map[PlayerHand]map[DealerHand]PlayerAction
+	cardCountMap    map[int]map[PlayerHand]map[DealerHand]PlayerAction
+	twoCardComboMap map[TwoCardCombo]map[DealerHand]PlayerAction
 	mainGameBetMap  map[int]int
+	spotsMap        map[int]int
 	initialBankroll int
 
 	raw []byte
 }
 
+type InvalidCell struct {
+	Section string
+	Index   int
+	Hand    string
+	Dealer  string
+	Value   byte
+	Allowed []byte
+}
+
+type InvalidStrategyError struct {
+	Cells []InvalidCell
+}
+
+func (err *InvalidStrategyError) Error() string {
+	descriptions := lo.Map(err.Cells, func(cell InvalidCell, _ int) string {
+		return fmt.Sprintf("%s is %q, expected one of %q", findSection(cell.Section).CellLabel(cell.Index), cell.Value, cell.Allowed)
+	})
+
+	return fmt.Sprintf("invalid strategy, %d bad cells: %s", len(err.Cells), strings.Join(descriptions, "; "))
+}
+
 // Factory
 
 func NewStrategy(raw []byte) (*Strategy, error) {
 	strategy := &Strategy{}
 
+	raw, err := UpgradeStrategy(raw)
+	if err != nil {
+		return strategy, err
+	}
+
 	strategy.raw = raw
 
-	err := validateRawStrategy(raw)
+	err = validateRawStrategy(raw)
 	if err != nil {
 		return strategy, err
 	}
@@ -49,62 +90,160 @@ func NewStrategy(raw []byte) (*Strategy, error) {
 		return strategy, err
 	}
 
+	err = strategy.parseSpots(raw)
+	if err != nil {
+		return strategy, err
+	}
+
+	err = strategy.parseCompositionMaps(raw)
+	if err != nil {
+		return strategy, err
+	}
+
+	err = strategy.parsePostSplitMaps(raw)
+	if err != nil {
+		return strategy, err
+	}
+
 	return strategy, nil
 }
 
 // Static methods
 
 func GetSequencing() [][]byte {
-	newArrayFilledWith := func(length int, value []byte) [][]byte {
-		array := make([][]byte, length)
-		for i := range array {
-			array[i] = value
+	sequence := make([][]byte, 0)
+
+	// the header never changes, so each of its genes has a single value
+	for _, value := range strategyHeader() {
+		sequence = append(sequence, []byte{value})
+	}
+
+	for _, section := range STRATEGY_SECTIONS {
+		for i := 0; i < section.Length(); i++ {
+			sequence = append(sequence, section.Alphabet)
 		}
-		return array
 	}
 
-	hitStayDouble := []byte("HSD")
-	hitStayDoubleSplit := []byte("HSDP")
-	numbers := []byte("0123456789ABCDF")
+	return sequence
+}
 
-	hardMapLength := DealerHandCount * PlayerHardHandCount
-	softMapLength := DealerHandCount * PlayerSoftHandCount
-	pairMapLength := DealerHandCount * PlayerPairHandCount
-	bankrollLength := bankrollLength
-	bettingLength := mainBetLength
+// A run of genes of the sequencing, a row of a chart or a whole section
+type GeneBlock struct {
+	Section string
+	Label   string
+	Start   int
+	Length  int
+	// Rows of the same group whose totals are one apart are neighbours
+	Group string
+	// The hand total of a row, 0 for rows of pairs and two card combos and for whole sections
+	Total int
+}
 
-	sequence := make([][]byte, 0)
-	sequence = append(sequence, newArrayFilledWith(hardMapLength, hitStayDouble)...)
-	sequence = append(sequence, newArrayFilledWith(softMapLength, hitStayDouble)...)
-	sequence = append(sequence, newArrayFilledWith(pairMapLength, hitStayDoubleSplit)...)
-	sequence = append(sequence, newArrayFilledWith(bankrollLength, numbers)...)
-	sequence = append(sequence, newArrayFilledWith(bettingLength, numbers)...)
+// One block per section, the header left out
+func SectionBlocks() []GeneBlock {
+	blocks := []GeneBlock{}
+	for _, section := range STRATEGY_SECTIONS {
+		blocks = append(blocks, sectionBlock(section))
+	}
 
-	return sequence
+	return blocks
+}
+
+// One block per row of every chart, in order of hand total, and one per other section.
+// Card count rows neighbour the rows of the same number of cards only
+func RowBlocks() []GeneBlock {
+	blocks := []GeneBlock{}
+	for _, section := range STRATEGY_SECTIONS {
+		if section.RowLabels == nil || section.ColumnLabels == nil {
+			blocks = append(blocks, sectionBlock(section))
+			continue
+		}
+
+		for row, label := range section.RowLabels {
+			total, cards := rowTotal(label)
+			blocks = append(blocks, GeneBlock{
+				Section: section.Name,
+				Label:   label,
+				Start:   sectionOffset(section.Name) + row*section.Columns,
+				Length:  section.Columns,
+				Group:   strings.TrimSpace(section.Name + " " + cards),
+				Total:   total,
+			})
+		}
+	}
+
+	return blocks
+}
+
+// The sections holding numbers, their alphabets in ascending order
+var NUMERIC_SECTIONS = []string{"bankroll", "bet", "spots"}
+
+func NumericBlocks() []GeneBlock {
+	return lo.Filter(SectionBlocks(), func(block GeneBlock, _ int) bool {
+		return lo.Contains(NUMERIC_SECTIONS, block.Section)
+	})
 }
 
+// The gene of the self-adaptive mutation rate, taking the levels of genetics.RateGeneBases
+func RateGene() int {
+	return sectionOffset("mutation-rate")
+}
+
+// A gene mask, as long as the sequencing, holding the named sections at the values of a strategy and leaving every other gene at zero
+func FreezeSections(strategy Strategyish, names []string) ([]byte, error) {
+	raw := strategy.GetEncodedStrategy()
+	mask := make([]byte, len(raw))
+
+	for _, name := range names {
+		section, found := lo.Find(STRATEGY_SECTIONS[:], func(section StrategySection) bool { return section.Name == name })
+		if !found {
+			return nil, fmt.Errorf("unknown strategy section [%s]", name)
+		}
+
+		startsAt := sectionOffset(section.Name)
+		copy(mask[startsAt:startsAt+section.Length()], raw[startsAt:])
+	}
+
+	return mask, nil
+}
+
+// How much the spots a strategy plays grow with the count, from 0 for the same spots at every count
+// to 1 for a single spot at some counts and MaxSpots at others; the main bet is flat, so the wager grows alike
+func SpotSpread(strategy Strategyish) float64 {
+	spots := lo.Map(lo.Range(CountBucketCount), func(trueCount int, _ int) int { return strategy.Spots(trueCount) })
+
+	return float64(lo.Max(spots)-lo.Min(spots)) / float64(MaxSpots-1)
+}
+
+// The values SpotSpread can take, evenly spaced from 0 to 1
+const SpotSpreadLevels = MaxSpots
+
 // Public methods
 
 func (strategy *Strategy) GetInitialBankroll() float64 {
 	return float64(strategy.initialBankroll)
 }
 
-func (strategy *Strategy) Play(playerHand Hand, dealerHand Hand) PlayerAction {
+func (strategy *Strategy) Play(playerHand Hand, dealerHand Hand, context PlayContext) PlayerAction {
 	dealerScore, _ := dealerHand.Score()
-	playerScore, _ := playerHand.Score()
 	dealerHighScore := DealerHand(dealerScore.High)
-	playerHighScore := PlayerHand(playerScore.High)
-	playerLowScore := PlayerHand(playerScore.Low)
+	state := playerHand.State()
 
-	var action PlayerAction
-	if playerHand.IsPair() {
-		action = strategy.pairMap[playerLowScore][dealerHighScore]
-	} else if playerHand.HasSoftValue() {
-		action = strategy.softMap[playerHighScore][dealerHighScore]
-	} else {
-		action = strategy.hardMap[playerHighScore][dealerHighScore]
+	if context.IsSplit {
+		postSplit := strategy.actionMapFor(state, strategy.splitHardMap, strategy.splitSoftMap, strategy.splitPairMap)
+		action := postSplit[state.Row()][dealerHighScore]
+		if action != "" && action != NoOverride {
+			return action
+		}
 	}
 
+	override := strategy.compositionOverride(playerHand, state, dealerHighScore)
+	if override != "" && override != NoOverride {
+		return override
+	}
+
+	actionMap := strategy.actionMapFor(state, strategy.hardMap, strategy.softMap, strategy.pairMap)
+	action := actionMap[state.Row()][dealerHighScore]
 	if action == "" {
 		action = Stand
 	}
@@ -116,20 +255,50 @@ func (strategy *Strategy) Bet() int {
 	return strategy.mainGameBetMap[0]
 }
 
+func (strategy *Strategy) Spots(trueCount int) int {
+	return strategy.spotsMap[countBucket(trueCount)]
+}
+
 func (strategy *Strategy) GetEncodedStrategy() []byte {
 	return strategy.raw
 }
 
 // Private methods
 
+func (strategy *Strategy) actionMapFor(state HandState, hardMap, softMap, pairMap map[PlayerHand]map[DealerHand]PlayerAction) map[PlayerHand]map[DealerHand]PlayerAction {
+	switch state.Category {
+	case PairHand:
+		return pairMap
+	case SoftHand:
+		return softMap
+	default:
+		return hardMap
+	}
+}
+
+func (strategy *Strategy) compositionOverride(playerHand Hand, state HandState, dealerHand DealerHand) PlayerAction {
+	if state.Category != HardHand || state.Total < int(HardTwelve) || state.Total > int(HardSixteen) {
+		return ""
+	}
+
+	if state.Cards == 2 {
+		first, second := playerHand[0].Value()[0], playerHand[1].Value()[0]
+		combo := TwoCardCombo{High: lo.Max([]int{first, second}), Low: lo.Min([]int{first, second})}
+		return strategy.twoCardComboMap[combo][dealerHand]
+	}
+
+	bucket := lo.Min([]int{state.Cards - 3, CardCountBucketCount - 1})
+	return strategy.cardCountMap[bucket][state.Row()][dealerHand]
+}
+
 func (strategy *Strategy) parseActionMap(raw []byte) error {
-	rawHardMapStartsAt := 0
-	rawSoftMapStartsAt := rawHardMapStartsAt + (DealerHandCount * PlayerHardHandCount)
-	rawPairMapStartsAt := rawSoftMapStartsAt + (DealerHandCount * PlayerSoftHandCount)
+	rawHardMapStartsAt := sectionOffset("hard")
+	rawSoftMapStartsAt := sectionOffset("soft")
+	rawPairMapStartsAt := sectionOffset("pair")
 
 	rawHardMap := raw[rawHardMapStartsAt:rawSoftMapStartsAt]
 	rawSoftMap := raw[rawSoftMapStartsAt:rawPairMapStartsAt]
-	rawPairMap := raw[rawPairMapStartsAt:]
+	rawPairMap := raw[rawPairMapStartsAt : rawPairMapStartsAt+(DealerHandCount*PlayerPairHandCount)]
 
 	strategy.hardMap = strategy.parseHardMap(rawHardMap)
 	strategy.softMap = strategy.parseSoftMap(rawSoftMap)
@@ -160,8 +329,7 @@ func (strategy *Strategy) parsePairMap(slicedRaw []byte) map[PlayerHand]map[Deal
 }
 
 func (strategy *Strategy) parseBankroll(raw []byte) error {
-	rawHardMapStartsAt := 0
-	rawBankrollStartsAt := rawHardMapStartsAt + (DealerHandCount * PlayerHardHandCount) + (DealerHandCount * PlayerSoftHandCount) + (DealerHandCount * PlayerPairHandCount)
+	rawBankrollStartsAt := sectionOffset("bankroll")
 
 	rawBankrollHex := raw[rawBankrollStartsAt : rawBankrollStartsAt+bankrollLength]
 	parsed, parseErr := strconv.ParseInt(string(rawBankrollHex), 16, 32)
@@ -178,13 +346,7 @@ func (strategy *Strategy) parseBankroll(raw []byte) error {
 func (strategy *Strategy) parseMainBettingStrategy(raw []byte) error {
 	parsedMap := make(map[int]int)
 
-	rawHardMapStartsAt := 0
-	rawBettingStartsAt :=
-		rawHardMapStartsAt +
-			(DealerHandCount * PlayerHardHandCount) +
-			(DealerHandCount * PlayerSoftHandCount) +
-			(DealerHandCount * PlayerPairHandCount) +
-			bankrollLength
+	rawBettingStartsAt := sectionOffset("bet")
 
 	rawBetHex := raw[rawBettingStartsAt : rawBettingStartsAt+mainBetLength]
 	parsed, parseErr := strconv.ParseInt(string(rawBetHex), 16, 32)
@@ -199,19 +361,114 @@ func (strategy *Strategy) parseMainBettingStrategy(raw []byte) error {
 	return nil
 }
 
+func (strategy *Strategy) parseSpots(raw []byte) error {
+	parsedMap := make(map[int]int)
+
+	rawSpotsStartsAt := sectionOffset("spots")
+	rawSpots := raw[rawSpotsStartsAt : rawSpotsStartsAt+spotsLength]
+
+	for bucket, rawSpot := range rawSpots {
+		parsed, parseErr := strconv.Atoi(string(rawSpot))
+		if parseErr != nil {
+			return parseErr
+		}
+
+		parsedMap[bucket] = parsed
+	}
+
+	strategy.spotsMap = parsedMap
+
+	return nil
+}
+
+func (strategy *Strategy) parseCompositionMaps(raw []byte) error {
+	columns := [DealerHandCount]DealerHand{DealerTwo, DealerThree, DealerFour, DealerFive, DealerSix, DealerSeven, DealerEight, DealerNine, DealerTen, DealerAce}
+	rows := [CompositionHardHandCount]PlayerHand{HardTwelve, HardThirteen, HardFourteen, HardFifteen, HardSixteen}
+
+	rawCardCountStartsAt := sectionOffset("card-count")
+	rawTwoCardComboStartsAt := sectionOffset("two-card-combo")
+	bucketLength := CompositionHardHandCount * DealerHandCount
+
+	strategy.cardCountMap = make(map[int]map[PlayerHand]map[DealerHand]PlayerAction)
+	for bucket := 0; bucket < CardCountBucketCount; bucket++ {
+		bucketStartsAt := rawCardCountStartsAt + bucket*bucketLength
+		strategy.cardCountMap[bucket] = stringToMap(raw[bucketStartsAt:bucketStartsAt+bucketLength], columns[:], rows[:])
+	}
+
+	rawTwoCardCombos := raw[rawTwoCardComboStartsAt : rawTwoCardComboStartsAt+twoCardComboLength]
+	strategy.twoCardComboMap = stringToMap(rawTwoCardCombos, columns[:], TWO_CARD_COMBOS[:])
+
+	return nil
+}
+
+func (strategy *Strategy) parsePostSplitMaps(raw []byte) error {
+	rawHardMapStartsAt := sectionOffset("split-hard")
+	rawSoftMapStartsAt := sectionOffset("split-soft")
+	rawPairMapStartsAt := sectionOffset("split-pair")
+
+	rawHardMap := raw[rawHardMapStartsAt:rawSoftMapStartsAt]
+	rawSoftMap := raw[rawSoftMapStartsAt:rawPairMapStartsAt]
+	rawPairMap := raw[rawPairMapStartsAt : rawPairMapStartsAt+(DealerHandCount*PlayerPairHandCount)]
+
+	strategy.splitHardMap = strategy.parseHardMap(rawHardMap)
+	strategy.splitSoftMap = strategy.parseSoftMap(rawSoftMap)
+	strategy.splitPairMap = strategy.parsePairMap(rawPairMap)
+
+	return nil
+}
+
 // Helper methods
 
 func validateRawStrategy(raw []byte) error {
-	expectedLength := HandCount + bankrollLength + mainBetLength
-	if len(raw) != expectedLength {
-		return fmt.Errorf("expected strategy length to be %d, got %d", expectedLength, len(raw))
+	invalidCells := []InvalidCell{}
+	for _, section := range STRATEGY_SECTIONS {
+		cursor := sectionOffset(section.Name)
+		for index, value := range raw[cursor : cursor+section.Length()] {
+			if bytes.IndexByte(section.Alphabet, value) >= 0 {
+				continue
+			}
+
+			row, column := index/section.Columns, index%section.Columns
+			invalidCells = append(invalidCells, InvalidCell{
+				Section: section.Name,
+				Index:   index,
+				Hand:    labelAt(section.RowLabels, row),
+				Dealer:  labelAt(section.ColumnLabels, column),
+				Value:   value,
+				Allowed: section.Alphabet,
+			})
+		}
+	}
+
+	if len(invalidCells) > 0 {
+		return &InvalidStrategyError{Cells: invalidCells}
 	}
 
 	return nil
 }
 
-func stringToMap(raw []byte, columns []DealerHand, rows []PlayerHand) map[PlayerHand]map[DealerHand]PlayerAction {
-	parsedMap := make(map[PlayerHand]map[DealerHand]PlayerAction)
+func labelAt(labels []string, index int) string {
+	if index >= len(labels) {
+		return ""
+	}
+
+	return labels[index]
+}
+
+func countBucket(trueCount int) int {
+	if trueCount <= 0 {
+		return 0
+	}
+
+	if trueCount >= CountBucketCount-1 {
+		return CountBucketCount - 1
+	}
+
+	return trueCount
+}
+
+func stringToMap[Row comparable](raw []byte, columns []DealerHand, rows []Row) map[Row]map[DealerHand]PlayerAction {
+	parsedMap := make(map[Row]map[DealerHand]PlayerAction)
 
 	cursor := 0
 	for _, row := range rows {
@@ -225,3 +482,18 @@ func stringToMap(raw []byte, columns []DealerHand, rows []PlayerHand) map[Player
 
 	return parsedMap
 }
+
+func sectionBlock(section StrategySection) GeneBlock {
+	return GeneBlock{Section: section.Name, Label: section.Name, Start: sectionOffset(section.Name), Length: section.Length(), Group: section.Name}
+}
+
+// The hand total of a row label such as "12" or "12 (3 cards)", and what follows it; no total for "8-8" or "10-2"
+func rowTotal(label string) (int, string) {
+	number, rest, _ := strings.Cut(label, " ")
+	total, err := strconv.Atoi(number)
+	if err != nil {
+		return 0, ""
+	}
+
+	return total, rest
+}
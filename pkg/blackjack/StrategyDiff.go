@@ -0,0 +1,145 @@
+package blackjack
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+type CellDifference struct {
+	Section string
+	Index   int
+	Hand    string
+	Dealer  string
+	Left    PlayerAction
+	Right   PlayerAction
+}
+
+type SectionDifference struct {
+	Section    string
+	Cells      int
+	Mismatches int
+}
+
+type StrategyDiff struct {
+	Cells    []CellDifference
+	Sections []SectionDifference
+
+	LeftBankroll  float64
+	RightBankroll float64
+	LeftBet       int
+	RightBet      int
+
+	left  []byte
+	right []byte
+}
+
+// The charts basic strategy is made of, used for the agreement between two strategies
+var BASIC_STRATEGY_SECTIONS = []string{"hard", "soft", "pair"}
+
+// Public methods
+
+func DiffStrategies(left Strategyish, right Strategyish) StrategyDiff {
+	diff := StrategyDiff{
+		LeftBankroll:  left.GetInitialBankroll(),
+		RightBankroll: right.GetInitialBankroll(),
+		LeftBet:       left.Bet(),
+		RightBet:      right.Bet(),
+		left:          left.GetEncodedStrategy(),
+		right:         right.GetEncodedStrategy(),
+	}
+
+	for _, section := range chartSections() {
+		startsAt := sectionOffset(section.Name)
+		sectionDifference := SectionDifference{Section: section.Name, Cells: section.Length()}
+
+		for index := 0; index < section.Length(); index++ {
+			leftCell, rightCell := diff.left[startsAt+index], diff.right[startsAt+index]
+			if leftCell == rightCell {
+				continue
+			}
+
+			sectionDifference.Mismatches++
+			diff.Cells = append(diff.Cells, CellDifference{
+				Section: section.Name,
+				Index:   index,
+				Hand:    section.RowLabels[index/section.Columns],
+				Dealer:  section.ColumnLabels[index%section.Columns],
+				Left:    PlayerAction(leftCell),
+				Right:   PlayerAction(rightCell),
+			})
+		}
+
+		diff.Sections = append(diff.Sections, sectionDifference)
+	}
+
+	return diff
+}
+
+func (diff StrategyDiff) IsEmpty() bool {
+	return len(diff.Cells) == 0 && diff.LeftBankroll == diff.RightBankroll && diff.LeftBet == diff.RightBet
+}
+
+// Share of basic strategy cells, from 0 to 1, where both strategies play the same
+func (diff StrategyDiff) Agreement() float64 {
+	basic := lo.Filter(diff.Sections, func(section SectionDifference, _ int) bool {
+		return lo.Contains(BASIC_STRATEGY_SECTIONS, section.Section)
+	})
+
+	cells := lo.SumBy(basic, func(section SectionDifference) int { return section.Cells })
+	mismatches := lo.SumBy(basic, func(section SectionDifference) int { return section.Mismatches })
+
+	return float64(cells-mismatches) / float64(cells)
+}
+
+// Prints every chart with disagreements, as left/right, followed by the mismatch counts
+func (diff StrategyDiff) String() string {
+	var builder strings.Builder
+
+	for _, sectionDifference := range diff.Sections {
+		if sectionDifference.Mismatches == 0 {
+			continue
+		}
+
+		section := findSection(sectionDifference.Section)
+		leftRows := sectionRows(diff.left, section)
+		rightRows := sectionRows(diff.right, section)
+
+		builder.WriteString(fmt.Sprintf("[%s]\n%14s", section.Name, ""))
+		for _, label := range section.ColumnLabels {
+			builder.WriteString(fmt.Sprintf(" %-3s", label))
+		}
+		builder.WriteString("\n")
+
+		for row, label := range section.RowLabels {
+			builder.WriteString(fmt.Sprintf("%14s", label))
+			for column := range section.ColumnLabels {
+				leftCell, rightCell := leftRows[row][column], rightRows[row][column]
+				if leftCell == rightCell {
+					builder.WriteString(fmt.Sprintf("  %c ", leftCell))
+				} else {
+					builder.WriteString(fmt.Sprintf(" %c/%c", leftCell, rightCell))
+				}
+			}
+			builder.WriteString("\n")
+		}
+		builder.WriteString("\n")
+	}
+
+	for _, sectionDifference := range diff.Sections {
+		builder.WriteString(fmt.Sprintf("%s: %d of %d cells differ\n", sectionDifference.Section, sectionDifference.Mismatches, sectionDifference.Cells))
+	}
+
+	if diff.LeftBankroll != diff.RightBankroll {
+		builder.WriteString(fmt.Sprintf("bankroll: %.0f/%.0f\n", diff.LeftBankroll, diff.RightBankroll))
+	}
+
+	if diff.LeftBet != diff.RightBet {
+		builder.WriteString(fmt.Sprintf("bet: %d/%d\n", diff.LeftBet, diff.RightBet))
+	}
+
+	builder.WriteString(fmt.Sprintf("basic strategy agreement: %.1f%%\n", diff.Agreement()*100))
+
+	return builder.String()
+}
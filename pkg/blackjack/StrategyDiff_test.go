@@ -0,0 +1,89 @@
+package blackjack
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Helpers
+
+func findSectionDifference(diff StrategyDiff, name string) (SectionDifference, bool) {
+	for _, section := range diff.Sections {
+		if section.Section == name {
+			return section, true
+		}
+	}
+
+	return SectionDifference{}, false
+}
+
+// Tests
+
+func TestStrategyDiff(t *testing.T) {
+	raw, err := getTestStrategy()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ideal, _ := NewStrategy(raw)
+
+	changed := append([]byte{}, raw...)
+	hard16Vs10 := sectionOffset("hard") + int(HardSixteen-HardFive)*DealerHandCount + int(DealerTen-DealerTwo)
+	pair8Vs2 := sectionOffset("pair") + 6*DealerHandCount
+	changed[hard16Vs10] = 'S'
+	changed[pair8Vs2] = 'H'
+	copy(changed[sectionOffset("bet"):], "0010")
+	other, _ := NewStrategy(changed)
+
+	t.Run("Should find nothing between a strategy and itself", func(t *testing.T) {
+		diff := DiffStrategies(ideal, ideal)
+
+		assert.True(t, diff.IsEmpty())
+		assert.Equal(t, 1.0, diff.Agreement())
+	})
+
+	t.Run("Should list every cell that differs, with its coordinates", func(t *testing.T) {
+		diff := DiffStrategies(ideal, other)
+
+		assert.Equal(t, []CellDifference{
+			{Section: "hard", Index: hard16Vs10 - sectionOffset("hard"), Hand: "16", Dealer: "10", Left: Hit, Right: Stand},
+			{Section: "pair", Index: pair8Vs2 - sectionOffset("pair"), Hand: "8-8", Dealer: "2", Left: SplitOrHit, Right: Hit},
+		}, diff.Cells)
+	})
+
+	t.Run("Should count mismatches per section", func(t *testing.T) {
+		diff := DiffStrategies(ideal, other)
+
+		hard, _ := findSectionDifference(diff, "hard")
+		soft, _ := findSectionDifference(diff, "soft")
+
+		assert.Equal(t, SectionDifference{Section: "hard", Cells: 160, Mismatches: 1}, hard)
+		assert.Equal(t, SectionDifference{Section: "soft", Cells: 80, Mismatches: 0}, soft)
+	})
+
+	t.Run("Should compare bankroll and bet", func(t *testing.T) {
+		diff := DiffStrategies(ideal, other)
+
+		assert.False(t, diff.IsEmpty())
+		assert.Equal(t, 1, diff.LeftBet)
+		assert.Equal(t, 16, diff.RightBet)
+		assert.Equal(t, diff.LeftBankroll, diff.RightBankroll)
+	})
+
+	t.Run("Should measure agreement over the basic strategy charts", func(t *testing.T) {
+		diff := DiffStrategies(ideal, other)
+
+		assert.InDelta(t, 338.0/340.0, diff.Agreement(), 1e-9)
+	})
+
+	t.Run("Should print the disagreements in a grid", func(t *testing.T) {
+		printed := DiffStrategies(ideal, other).String()
+
+		assert.Contains(t, printed, "[hard]\n")
+		assert.Contains(t, printed, "            16  S   S   S   S   S   H   H   H  H/S  H \n")
+		assert.NotContains(t, printed, "[soft]\n")
+		assert.Contains(t, printed, "pair: 1 of 100 cells differ\n")
+		assert.Contains(t, printed, "bet: 1/16\n")
+		assert.Contains(t, printed, "basic strategy agreement: 99.4%\n")
+	})
+}
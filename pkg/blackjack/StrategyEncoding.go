@@ -0,0 +1,260 @@
+package blackjack
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	"github.com/samber/lo"
+)
+
+// A raw strategy starts with a header naming the sections it holds, so new sections don't break old ones:
+// magic "BJ", version (2 hex digits), section count (2 hex digits), then a code and 3 hex digit length per section.
+// Strategies saved before the header existed are told apart by their length.
+// Any known version is read with its own sections and upgraded by filling the sections it lacks with their defaults.
+
+const CurrentStrategyVersion = 6
+
+// Strategies of earlier versions were saved without a header
+const firstHeaderedVersion = 5
+
+const strategyMagic = "BJ"
+const sectionEntryLength = 4
+
+// The sections each version knows, in the order a headerless strategy of that version lays them out
+var strategyLayouts = map[int][]string{
+	1: {"hard", "soft", "pair", "bankroll", "bet"},
+	2: {"hard", "soft", "pair", "bankroll", "bet", "spots"},
+	3: {"hard", "soft", "pair", "bankroll", "bet", "spots", "card-count", "two-card-combo"},
+	4: {"hard", "soft", "pair", "bankroll", "bet", "spots", "card-count", "two-card-combo", "split-hard", "split-soft", "split-pair"},
+	5: {"hard", "soft", "pair", "bankroll", "bet", "spots", "card-count", "two-card-combo", "split-hard", "split-soft", "split-pair"},
+	6: {"hard", "soft", "pair", "bankroll", "bet", "spots", "card-count", "two-card-combo", "split-hard", "split-soft", "split-pair", "mutation-rate"},
+}
+
+var strategyHeaderLength = len(strategyHeader())
+
+// Public methods
+
+func StrategyVersion(raw []byte) (int, error) {
+	if bytes.HasPrefix(raw, []byte(strategyMagic)) {
+		return parseHeaderVersion(raw)
+	}
+
+	version, _, found := findLegacyLayout(len(raw))
+	if !found {
+		return 0, fmt.Errorf("unknown strategy encoding of length %d", len(raw))
+	}
+
+	return version, nil
+}
+
+// Converts a strategy of any version to the current encoding, filling new sections with their defaults
+func UpgradeStrategy(raw []byte) ([]byte, error) {
+	sections, err := decodeSections(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	upgraded := strategyHeader()
+	for _, section := range STRATEGY_SECTIONS {
+		cells, ok := sections[section.Name]
+		if ok {
+			upgraded = append(upgraded, cells...)
+			continue
+		}
+
+		if section.Default == 0 {
+			return nil, fmt.Errorf("strategy is missing required section [%s]", section.Name)
+		}
+
+		upgraded = append(upgraded, bytes.Repeat([]byte{section.Default}, section.Length())...)
+	}
+
+	return upgraded, nil
+}
+
+// Private methods
+
+func strategyHeader() []byte {
+	header := []byte(fmt.Sprintf("%s%02X%02X", strategyMagic, CurrentStrategyVersion, len(STRATEGY_SECTIONS)))
+	for _, section := range STRATEGY_SECTIONS {
+		header = append(header, []byte(fmt.Sprintf("%c%03X", section.Code, section.Length()))...)
+	}
+
+	return header
+}
+
+// Where a section starts in a strategy of the current version
+func sectionOffset(name string) int {
+	offset := strategyHeaderLength
+	for _, section := range STRATEGY_SECTIONS {
+		if section.Name == name {
+			return offset
+		}
+		offset += section.Length()
+	}
+
+	panic("Unknown section " + name)
+}
+
+// The section a cell of a strategy of the current version belongs to, and the cell index within it
+func sectionAt(index int) (StrategySection, int, bool) {
+	offset := strategyHeaderLength
+	for _, section := range STRATEGY_SECTIONS {
+		if index >= offset && index < offset+section.Length() {
+			return section, index - offset, true
+		}
+		offset += section.Length()
+	}
+
+	return StrategySection{}, 0, false
+}
+
+// The cells of a section in a strategy of the current version, one slice per row
+func sectionRows(raw []byte, section StrategySection) [][]byte {
+	startsAt := sectionOffset(section.Name)
+	rows := make([][]byte, section.Rows)
+	for row := range rows {
+		rows[row] = raw[startsAt+row*section.Columns : startsAt+(row+1)*section.Columns]
+	}
+
+	return rows
+}
+
+func decodeSections(raw []byte) (map[string][]byte, error) {
+	if bytes.HasPrefix(raw, []byte(strategyMagic)) {
+		return decodeHeaderedSections(raw)
+	}
+
+	_, names, found := findLegacyLayout(len(raw))
+	if !found {
+		expectedLengths := []int{}
+		for version := 1; version < firstHeaderedVersion; version++ {
+			expectedLengths = append(expectedLengths, layoutLength(strategyLayouts[version]))
+		}
+		return nil, fmt.Errorf("expected strategy length to be one of %v, or a strategy header, got %d", expectedLengths, len(raw))
+	}
+
+	sections := map[string][]byte{}
+	cursor := 0
+	for _, name := range names {
+		section := findSection(name)
+		sections[name] = raw[cursor : cursor+section.Length()]
+		cursor += section.Length()
+	}
+
+	return sections, nil
+}
+
+func decodeHeaderedSections(raw []byte) (map[string][]byte, error) {
+	version, err := parseHeaderVersion(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	layout := strategyLayouts[version]
+
+	countStartsAt := len(strategyMagic) + 2
+	count, err := parseHeaderNumber(raw, countStartsAt, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyStartsAt := countStartsAt + 2 + count*sectionEntryLength
+	if len(raw) < bodyStartsAt {
+		return nil, fmt.Errorf("strategy header lists %d sections but is only %d long", count, len(raw))
+	}
+
+	sections := map[string][]byte{}
+	cursor := bodyStartsAt
+	for entry := 0; entry < count; entry++ {
+		entryStartsAt := countStartsAt + 2 + entry*sectionEntryLength
+		code := raw[entryStartsAt]
+
+		section, found := lo.Find(STRATEGY_SECTIONS[:], func(section StrategySection) bool { return section.Code == code })
+		if !found {
+			return nil, fmt.Errorf("unknown strategy section code %q", code)
+		}
+
+		if !lo.Contains(layout, section.Name) {
+			return nil, fmt.Errorf("section [%s] is not part of strategy version %d", section.Name, version)
+		}
+
+		length, err := parseHeaderNumber(raw, entryStartsAt+1, sectionEntryLength-1)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, seen := sections[section.Name]; seen {
+			return nil, fmt.Errorf("section [%s] appears twice in the strategy header", section.Name)
+		}
+
+		if length != section.Length() {
+			return nil, fmt.Errorf("section [%s] has length %d, expected %d", section.Name, length, section.Length())
+		}
+
+		if cursor+length > len(raw) {
+			return nil, fmt.Errorf("section [%s] runs past the end of the strategy", section.Name)
+		}
+
+		sections[section.Name] = raw[cursor : cursor+length]
+		cursor += length
+	}
+
+	if cursor != len(raw) {
+		return nil, fmt.Errorf("strategy has %d bytes after its last section", len(raw)-cursor)
+	}
+
+	return sections, nil
+}
+
+func parseHeaderVersion(raw []byte) (int, error) {
+	version, err := parseHeaderNumber(raw, len(strategyMagic), 2)
+	if err != nil {
+		return 0, err
+	}
+
+	if _, known := strategyLayouts[version]; !known {
+		return 0, fmt.Errorf("unsupported strategy version %d", version)
+	}
+
+	return version, nil
+}
+
+// Helper methods
+
+func parseHeaderNumber(raw []byte, startsAt int, length int) (int, error) {
+	if len(raw) < startsAt+length {
+		return 0, fmt.Errorf("strategy header is cut short")
+	}
+
+	parsed, err := strconv.ParseInt(string(raw[startsAt:startsAt+length]), 16, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid strategy header: %w", err)
+	}
+
+	return int(parsed), nil
+}
+
+func findLegacyLayout(length int) (version int, names []string, found bool) {
+	for version := 1; version < firstHeaderedVersion; version++ {
+		if layoutLength(strategyLayouts[version]) == length {
+			return version, strategyLayouts[version], true
+		}
+	}
+
+	return 0, nil, false
+}
+
+func layoutLength(names []string) int {
+	return lo.SumBy(names, func(name string) int { return findSection(name).Length() })
+}
+
+func findSection(name string) StrategySection {
+	section, found := lo.Find(STRATEGY_SECTIONS[:], func(section StrategySection) bool { return section.Name == name })
+	if !found {
+		panic("Unknown section " + name)
+	}
+
+	return section
+}
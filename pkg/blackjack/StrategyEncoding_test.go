@@ -0,0 +1,229 @@
+package blackjack
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Helpers
+
+func sectionCells(raw []byte, name string) []byte {
+	return raw[sectionOffset(name) : sectionOffset(name)+findSection(name).Length()]
+}
+
+func legacyStrategy(raw []byte, version int) []byte {
+	legacy := []byte{}
+	for _, name := range strategyLayouts[version] {
+		legacy = append(legacy, sectionCells(raw, name)...)
+	}
+
+	return legacy
+}
+
+func headeredStrategy(raw []byte, version int) []byte {
+	names := strategyLayouts[version]
+	headered := []byte(fmt.Sprintf("%s%02X%02X", strategyMagic, version, len(names)))
+	for _, name := range names {
+		headered = append(headered, []byte(fmt.Sprintf("%c%03X", findSection(name).Code, findSection(name).Length()))...)
+	}
+
+	return append(headered, legacyStrategy(raw, version)...)
+}
+
+// Tests
+
+func TestStrategyVersion(t *testing.T) {
+	raw, err := getTestStrategy()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for version := 1; version < firstHeaderedVersion; version++ {
+		t.Run(fmt.Sprintf("Should recognize headerless version %d by its length", version), func(t *testing.T) {
+			got, err := StrategyVersion(legacyStrategy(raw, version))
+
+			assert.NoError(t, err)
+			assert.Equal(t, version, got)
+		})
+	}
+
+	t.Run("Should read the version from the header", func(t *testing.T) {
+		got, err := StrategyVersion(raw)
+
+		assert.NoError(t, err)
+		assert.Equal(t, CurrentStrategyVersion, got)
+	})
+
+	t.Run("Should read an older version from its header", func(t *testing.T) {
+		got, err := StrategyVersion(headeredStrategy(raw, 4))
+
+		assert.NoError(t, err)
+		assert.Equal(t, 4, got)
+	})
+
+	t.Run("Should fail on an unknown length", func(t *testing.T) {
+		_, err := StrategyVersion([]byte("HSD"))
+		assert.Error(t, err)
+	})
+}
+
+func TestStrategyUpgrade(t *testing.T) {
+	raw, err := getTestStrategy()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("Should start every strategy with a header", func(t *testing.T) {
+		assert.True(t, bytes.HasPrefix(raw, strategyHeader()))
+	})
+
+	t.Run("Should keep the sections of an old strategy", func(t *testing.T) {
+		upgraded, err := UpgradeStrategy(legacyStrategy(raw, 1))
+
+		assert.NoError(t, err)
+		for _, name := range strategyLayouts[1] {
+			assert.Equal(t, sectionCells(raw, name), sectionCells(upgraded, name))
+		}
+	})
+
+	t.Run("Should fill the sections an old strategy lacks with their defaults", func(t *testing.T) {
+		upgraded, _ := UpgradeStrategy(legacyStrategy(raw, 1))
+
+		assert.Equal(t, []byte("11111"), sectionCells(upgraded, "spots"))
+		assert.Equal(t, bytes.Repeat([]byte("-"), PlayerHardHandCount*DealerHandCount), sectionCells(upgraded, "split-hard"))
+	})
+
+	t.Run("Should upgrade a headerless strategy of the current layout to the same strategy", func(t *testing.T) {
+		upgraded, err := UpgradeStrategy(legacyStrategy(raw, firstHeaderedVersion-1))
+
+		assert.NoError(t, err)
+		assert.Equal(t, raw, upgraded)
+	})
+
+	t.Run("Should upgrade an older strategy written with a header", func(t *testing.T) {
+		upgraded, err := UpgradeStrategy(headeredStrategy(raw, 4))
+
+		assert.NoError(t, err)
+		assert.Equal(t, raw, upgraded)
+	})
+
+	t.Run("Should fill the sections an older headered strategy lacks with their defaults", func(t *testing.T) {
+		upgraded, err := UpgradeStrategy(headeredStrategy(raw, 2))
+
+		assert.NoError(t, err)
+		assert.Equal(t, sectionCells(raw, "spots"), sectionCells(upgraded, "spots"))
+		assert.Equal(t, bytes.Repeat([]byte("-"), cardCountLength), sectionCells(upgraded, "card-count"))
+	})
+
+	t.Run("Should leave a current strategy as it is", func(t *testing.T) {
+		upgraded, err := UpgradeStrategy(raw)
+
+		assert.NoError(t, err)
+		assert.Equal(t, raw, upgraded)
+	})
+
+	t.Run("Should let NewStrategy read old strategies", func(t *testing.T) {
+		strategy, err := NewStrategy(legacyStrategy(raw, 1))
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1000.0, strategy.GetInitialBankroll())
+		assert.Equal(t, 1, strategy.Spots(4))
+		assert.Equal(t, raw[:sectionOffset("spots")], strategy.GetEncodedStrategy()[:sectionOffset("spots")])
+	})
+}
+
+func TestStrategyHeader(t *testing.T) {
+	raw, err := getTestStrategy()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	header := func(entries ...string) []byte {
+		return []byte(fmt.Sprintf("%s%02X%02X%s", strategyMagic, CurrentStrategyVersion, len(entries), strings.Join(entries, "")))
+	}
+
+	hard := sectionCells(raw, "hard")
+	soft := sectionCells(raw, "soft")
+	pair := sectionCells(raw, "pair")
+	bankroll := sectionCells(raw, "bankroll")
+	bet := sectionCells(raw, "bet")
+	required := []string{"h0A0", "s050", "p064", "b004", "m004"}
+
+	t.Run("Should read sections in the order the header lists them", func(t *testing.T) {
+		reordered := header("m004", "b004", "p064", "s050", "h0A0")
+		reordered = append(reordered, bytes.Join([][]byte{[]byte("0002"), bankroll, pair, soft, hard}, nil)...)
+
+		strategy, err := NewStrategy(reordered)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, strategy.Bet())
+		assert.Equal(t, raw[:sectionOffset("bet")], strategy.GetEncodedStrategy()[:sectionOffset("bet")])
+	})
+
+	t.Run("Should fill optional sections the header leaves out", func(t *testing.T) {
+		partial := append(header(required...), bytes.Join([][]byte{hard, soft, pair, bankroll, bet}, nil)...)
+
+		strategy, err := NewStrategy(partial)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, strategy.Spots(0))
+	})
+
+	testCases := []struct {
+		desc string
+		raw  []byte
+		want string
+	}{
+		{
+			desc: "Should fail on a missing required section",
+			raw:  append(header("h0A0"), hard...),
+			want: "strategy is missing required section [soft]",
+		},
+		{
+			desc: "Should fail on an unknown section code",
+			raw:  append(header("x001"), 'H'),
+			want: "unknown strategy section code 'x'",
+		},
+		{
+			desc: "Should fail on a section length that does not match",
+			raw:  append(header("h001"), 'H'),
+			want: "section [hard] has length 1, expected 160",
+		},
+		{
+			desc: "Should fail on a section listed twice",
+			raw:  append(header("m004", "m004"), []byte("00010001")...),
+			want: "section [bet] appears twice in the strategy header",
+		},
+		{
+			desc: "Should fail on a section cut short",
+			raw:  append(header("m004"), []byte("001")...),
+			want: "section [bet] runs past the end of the strategy",
+		},
+		{
+			desc: "Should fail on bytes after the last section",
+			raw:  append(header("m004"), []byte("00010")...),
+			want: "strategy has 1 bytes after its last section",
+		},
+		{
+			desc: "Should fail on a section the header's version does not know",
+			raw:  append([]byte("BJ0101n005"), []byte("11111")...),
+			want: "section [spots] is not part of strategy version 1",
+		},
+		{
+			desc: "Should fail on an unsupported version",
+			raw:  []byte("BJ9900"),
+			want: "unsupported strategy version 153",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.desc, func(t *testing.T) {
+			_, err := NewStrategy(testCase.raw)
+			assert.EqualError(t, err, testCase.want)
+		})
+	}
+}
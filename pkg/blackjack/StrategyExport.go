@@ -0,0 +1,216 @@
+package blackjack
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"html"
+	"strconv"
+)
+
+type ActionStyle struct {
+	Name       string
+	Background string
+	Foreground string
+}
+
+// Chart colors, as printed on most basic strategy cards
+var ACTION_STYLES = map[PlayerAction]ActionStyle{
+	Hit:          {"Hit", "#2e7d32", "#ffffff"},
+	Stand:        {"Stand", "#c62828", "#ffffff"},
+	Double:       {"Double", "#f9a825", "#000000"},
+	SplitOrHit:   {"Split", "#1565c0", "#ffffff"},
+	SplitOrStand: {"Split, or stand", "#6a1b9a", "#ffffff"},
+	Surrender:    {"Surrender", "#9e9e9e", "#000000"},
+	NoOverride:   {"Use the chart for the total", "#eeeeee", "#000000"},
+}
+
+var actionLegendOrder = []PlayerAction{Hit, Stand, Double, SplitOrHit, SplitOrStand, Surrender, NoOverride}
+
+type StrategyExport struct {
+	Version  int            `json:"version"`
+	Bankroll int            `json:"bankroll"`
+	Bet      int            `json:"bet"`
+	Spots    map[string]int `json:"spots"`
+	// Section, then player hand, then dealer upcard
+	Charts map[string]map[string]map[string]string `json:"charts"`
+}
+
+// Public methods
+
+func NewStrategyExport(strategy Strategyish) StrategyExport {
+	raw := strategy.GetEncodedStrategy()
+
+	export := StrategyExport{
+		Version:  CurrentStrategyVersion,
+		Bankroll: int(strategy.GetInitialBankroll()),
+		Bet:      strategy.Bet(),
+		Spots:    map[string]int{},
+		Charts:   map[string]map[string]map[string]string{},
+	}
+
+	for bucket, label := range COUNT_BUCKET_LABELS {
+		export.Spots[label] = strategy.Spots(bucket)
+	}
+
+	for _, section := range chartSections() {
+		chart := map[string]map[string]string{}
+		for row, cells := range sectionRows(raw, section) {
+			hand := map[string]string{}
+			for column, cell := range cells {
+				hand[section.ColumnLabels[column]] = string(cell)
+			}
+			chart[section.RowLabels[row]] = hand
+		}
+		export.Charts[section.Name] = chart
+	}
+
+	return export
+}
+
+func ExportStrategyJSON(strategy Strategyish) ([]byte, error) {
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+
+	err := encoder.Encode(NewStrategyExport(strategy))
+	return buffer.Bytes(), err
+}
+
+// One grid per chart, then the bankroll, bet and spots, separated by empty records
+func ExportStrategyCSV(strategy Strategyish) ([]byte, error) {
+	raw := strategy.GetEncodedStrategy()
+
+	var buffer bytes.Buffer
+	writer := csv.NewWriter(&buffer)
+
+	for _, section := range chartSections() {
+		writer.Write(append([]string{section.Name}, section.ColumnLabels...))
+		for row, cells := range sectionRows(raw, section) {
+			record := []string{section.RowLabels[row]}
+			for _, cell := range cells {
+				record = append(record, string(cell))
+			}
+			writer.Write(record)
+		}
+		writer.Write([]string{})
+	}
+
+	writer.Write([]string{"bankroll", strconv.Itoa(int(strategy.GetInitialBankroll()))})
+	writer.Write([]string{"bet", strconv.Itoa(strategy.Bet())})
+	writer.Write(append([]string{"spots"}, COUNT_BUCKET_LABELS...))
+
+	spots := []string{""}
+	for bucket := range COUNT_BUCKET_LABELS {
+		spots = append(spots, strconv.Itoa(strategy.Spots(bucket)))
+	}
+	writer.Write(spots)
+
+	writer.Flush()
+	return buffer.Bytes(), writer.Error()
+}
+
+// A standalone page with one SVG chart per section
+func ExportStrategyHTML(strategy Strategyish, title string) []byte {
+	raw := strategy.GetEncodedStrategy()
+
+	var buffer bytes.Buffer
+	buffer.WriteString("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n")
+	buffer.WriteString(fmt.Sprintf("<title>%s</title>\n", html.EscapeString(title)))
+	buffer.WriteString("<style>body { font-family: sans-serif; } section { display: inline-block; margin: 0 2em 2em 0; vertical-align: top; }</style>\n")
+	buffer.WriteString("</head>\n<body>\n")
+	buffer.WriteString(fmt.Sprintf("<h1>%s</h1>\n", html.EscapeString(title)))
+	buffer.WriteString(fmt.Sprintf("<p>Bankroll %d, bet %d</p>\n", int(strategy.GetInitialBankroll()), strategy.Bet()))
+
+	buffer.WriteString("<p>Spots:")
+	for bucket, label := range COUNT_BUCKET_LABELS {
+		buffer.WriteString(fmt.Sprintf(" %s &rarr; %d;", html.EscapeString(label), strategy.Spots(bucket)))
+	}
+	buffer.WriteString("</p>\n")
+
+	buffer.Write(renderLegend())
+
+	for _, section := range chartSections() {
+		buffer.WriteString(fmt.Sprintf("<section>\n<h2>%s</h2>\n", html.EscapeString(section.Description)))
+		buffer.Write(renderChart(section, sectionRows(raw, section)))
+		buffer.WriteString("</section>\n")
+	}
+
+	buffer.WriteString("</body>\n</html>\n")
+	return buffer.Bytes()
+}
+
+// Helper methods
+
+const chartCellSize = 28
+const chartLabelWidth = 96
+
+// The sections laid out as a grid of hands by dealer upcard
+func chartSections() []StrategySection {
+	sections := []StrategySection{}
+	for _, section := range STRATEGY_SECTIONS {
+		if section.RowLabels != nil && section.ColumnLabels != nil {
+			sections = append(sections, section)
+		}
+	}
+
+	return sections
+}
+
+func renderLegend() []byte {
+	var buffer bytes.Buffer
+	width := len(actionLegendOrder) * 4 * chartCellSize
+
+	buffer.WriteString(fmt.Sprintf("<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"%d\" height=\"%d\">\n", width, chartCellSize))
+	for i, action := range actionLegendOrder {
+		style := ACTION_STYLES[action]
+		x := i * 4 * chartCellSize
+
+		buffer.Write(renderCell(x, 0, action))
+		buffer.WriteString(fmt.Sprintf("<text x=\"%d\" y=\"%d\" font-size=\"11\">%s</text>\n", x+chartCellSize+4, chartCellSize/2+4, html.EscapeString(style.Name)))
+	}
+	buffer.WriteString("</svg>\n")
+
+	return buffer.Bytes()
+}
+
+func renderChart(section StrategySection, rows [][]byte) []byte {
+	var buffer bytes.Buffer
+	width := chartLabelWidth + section.Columns*chartCellSize
+	height := (section.Rows + 1) * chartCellSize
+
+	buffer.WriteString(fmt.Sprintf("<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"%d\" height=\"%d\">\n", width, height))
+
+	for column, label := range section.ColumnLabels {
+		x := chartLabelWidth + column*chartCellSize
+		buffer.WriteString(fmt.Sprintf("<text x=\"%d\" y=\"%d\" text-anchor=\"middle\" font-weight=\"bold\">%s</text>\n", x+chartCellSize/2, chartCellSize/2+5, html.EscapeString(label)))
+	}
+
+	for row, cells := range rows {
+		y := (row + 1) * chartCellSize
+		buffer.WriteString(fmt.Sprintf("<text x=\"%d\" y=\"%d\" text-anchor=\"end\" font-weight=\"bold\">%s</text>\n", chartLabelWidth-6, y+chartCellSize/2+5, html.EscapeString(section.RowLabels[row])))
+
+		for column, cell := range cells {
+			buffer.Write(renderCell(chartLabelWidth+column*chartCellSize, y, PlayerAction(cell)))
+		}
+	}
+
+	buffer.WriteString("</svg>\n")
+	return buffer.Bytes()
+}
+
+func renderCell(x int, y int, action PlayerAction) []byte {
+	style, ok := ACTION_STYLES[action]
+	if !ok {
+		style = ActionStyle{string(action), "#ffffff", "#000000"}
+	}
+
+	return []byte(fmt.Sprintf(
+		"<rect x=\"%d\" y=\"%d\" width=\"%d\" height=\"%d\" fill=\"%s\" stroke=\"#ffffff\"><title>%s</title></rect>\n"+
+			"<text x=\"%d\" y=\"%d\" text-anchor=\"middle\" fill=\"%s\">%s</text>\n",
+		x, y, chartCellSize, chartCellSize, style.Background, html.EscapeString(style.Name),
+		x+chartCellSize/2, y+chartCellSize/2+5, style.Foreground, html.EscapeString(string(action)),
+	))
+}
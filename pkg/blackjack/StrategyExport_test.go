@@ -0,0 +1,113 @@
+package blackjack
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Helpers
+
+func getTestStrategyValue(t *testing.T) *Strategy {
+	raw, err := getTestStrategy()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	strategy, err := NewStrategy(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return strategy
+}
+
+// Tests
+
+func TestStrategyExportJSON(t *testing.T) {
+	strategy := getTestStrategyValue(t)
+
+	content, err := ExportStrategyJSON(strategy)
+	assert.NoError(t, err)
+
+	var export StrategyExport
+	err = json.Unmarshal(content, &export)
+	assert.NoError(t, err)
+
+	t.Run("Should nest actions by hand type, hand and dealer upcard", func(t *testing.T) {
+		assert.Equal(t, "H", export.Charts["hard"]["16"]["10"])
+		assert.Equal(t, "D", export.Charts["soft"]["17"]["5"])
+		assert.Equal(t, "P", export.Charts["pair"]["A-A"]["A"])
+		assert.Equal(t, "S", export.Charts["card-count"]["16 (3 cards)"]["10"])
+	})
+
+	t.Run("Should export every chart section", func(t *testing.T) {
+		assert.Len(t, export.Charts, len(chartSections()))
+		assert.Len(t, export.Charts["hard"], PlayerHardHandCount)
+		assert.Len(t, export.Charts["hard"]["5"], DealerHandCount)
+	})
+
+	t.Run("Should export bankroll, bet and spots as numbers", func(t *testing.T) {
+		assert.Equal(t, 1000, export.Bankroll)
+		assert.Equal(t, 1, export.Bet)
+		assert.Equal(t, 1, export.Spots["TC >= 4"])
+		assert.Equal(t, CurrentStrategyVersion, export.Version)
+	})
+}
+
+func TestStrategyExportCSV(t *testing.T) {
+	strategy := getTestStrategyValue(t)
+
+	content, err := ExportStrategyCSV(strategy)
+	assert.NoError(t, err)
+
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	assert.NoError(t, err)
+
+	t.Run("Should start each grid with the dealer upcards", func(t *testing.T) {
+		assert.Equal(t, append([]string{"hard"}, DEALER_LABELS...), records[0])
+	})
+
+	t.Run("Should write one record per hand", func(t *testing.T) {
+		assert.Equal(t, []string{"5", "H", "H", "H", "H", "H", "H", "H", "H", "H", "H"}, records[1])
+		assert.Equal(t, []string{"16", "S", "S", "S", "S", "S", "H", "H", "H", "H", "H"}, records[int(HardSixteen-HardFive)+1])
+	})
+
+	t.Run("Should end with the bankroll, bet and spots", func(t *testing.T) {
+		last := records[len(records)-4:]
+
+		assert.Equal(t, []string{"bankroll", "1000"}, last[0])
+		assert.Equal(t, []string{"bet", "1"}, last[1])
+		assert.Equal(t, []string{"", "1", "1", "1", "1", "1"}, last[3])
+	})
+}
+
+func TestStrategyExportHTML(t *testing.T) {
+	strategy := getTestStrategyValue(t)
+	content := string(ExportStrategyHTML(strategy, "Ideal <6 decks>"))
+
+	t.Run("Should render a standalone page", func(t *testing.T) {
+		assert.True(t, strings.HasPrefix(content, "<!DOCTYPE html>"))
+		assert.Contains(t, content, "<title>Ideal &lt;6 decks&gt;</title>")
+	})
+
+	t.Run("Should render one SVG per chart, plus the legend", func(t *testing.T) {
+		assert.Equal(t, len(chartSections())+1, strings.Count(content, "<svg "))
+	})
+
+	t.Run("Should color every cell by its action", func(t *testing.T) {
+		cells := 0
+		for _, section := range chartSections() {
+			cells += section.Length()
+		}
+
+		assert.Equal(t, cells+len(actionLegendOrder), strings.Count(content, "<rect "))
+		assert.Contains(t, content, "fill=\""+ACTION_STYLES[SplitOrHit].Background+"\"")
+	})
+}
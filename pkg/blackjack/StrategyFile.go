@@ -0,0 +1,233 @@
+package blackjack
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+// The .strategy format is one grid row per line, in the same order as the raw strategy.
+// Sections can be named with a [section] header; without headers, rows fill the sections in order.
+// Anything after a # is a comment, and spaces between cells are ignored.
+
+type StrategyFileError struct {
+	Line    int
+	Column  int
+	Message string
+}
+
+func (err *StrategyFileError) Error() string {
+	if err.Column == 0 {
+		return fmt.Sprintf("line %d: %s", err.Line, err.Message)
+	}
+
+	return fmt.Sprintf("line %d, column %d: %s", err.Line, err.Column, err.Message)
+}
+
+type strategyFileCell struct {
+	value  byte
+	column int
+}
+
+type strategyFileSection struct {
+	rows       [][]byte
+	headerLine int
+}
+
+// Public methods
+
+func LoadStrategyFile(path string) (*Strategy, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	strategy, err := ParseStrategyFile(content)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return strategy, nil
+}
+
+// The raw strategy of a file, its chart cells left unchecked so a linter can report the ones that can't be played
+func LoadRawStrategyFile(path string) ([]byte, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := parseStrategyCells(content, false)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return raw, nil
+}
+
+func SaveStrategyFile(path string, strategy Strategyish) error {
+	return os.WriteFile(path, FormatStrategyFile(strategy), 0644)
+}
+
+func ParseStrategyFile(content []byte) (*Strategy, error) {
+	raw, err := parseStrategyCells(content, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewStrategy(raw)
+}
+
+func FormatStrategyFile(strategy Strategyish) []byte {
+	raw := strategy.GetEncodedStrategy()
+
+	var buffer bytes.Buffer
+	buffer.WriteString("# Actions: H hit, S stand, D double, P split, - use the chart for the total\n")
+
+	for _, section := range STRATEGY_SECTIONS {
+		buffer.WriteString(fmt.Sprintf("\n# %s\n[%s]\n", section.Description, section.Name))
+
+		for _, row := range sectionRows(raw, section) {
+			buffer.Write(row)
+			buffer.WriteString("\n")
+		}
+	}
+
+	return buffer.Bytes()
+}
+
+// Helper methods
+
+// The raw strategy a file lays out; cells outside the charts are always checked against their alphabet, chart cells when asked to
+func parseStrategyCells(content []byte, checkCharts bool) ([]byte, error) {
+	sections := make([]strategyFileSection, len(STRATEGY_SECTIONS))
+	current := -1
+	hasHeaders := false
+
+	lines := strings.Split(string(content), "\n")
+	for i, line := range lines {
+		lineNumber := i + 1
+
+		if commentAt := strings.IndexByte(line, '#'); commentAt >= 0 {
+			line = line[:commentAt]
+		}
+
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" {
+			continue
+		}
+
+		if strings.HasPrefix(trimmed, "[") {
+			index, err := parseSectionHeader(trimmed, lineNumber)
+			if err != nil {
+				return nil, err
+			}
+
+			if sections[index].headerLine != 0 {
+				return nil, &StrategyFileError{Line: lineNumber, Message: fmt.Sprintf("section [%s] appears twice", STRATEGY_SECTIONS[index].Name)}
+			}
+
+			sections[index].headerLine = lineNumber
+			current = index
+			hasHeaders = true
+			continue
+		}
+
+		// without headers, a row goes into the first section that still has room
+		if !hasHeaders {
+			for current < len(STRATEGY_SECTIONS) && (current < 0 || len(sections[current].rows) == STRATEGY_SECTIONS[current].Rows) {
+				current++
+			}
+
+			if current == len(STRATEGY_SECTIONS) {
+				return nil, &StrategyFileError{Line: lineNumber, Message: "unexpected row, every section is complete"}
+			}
+		}
+
+		section := STRATEGY_SECTIONS[current]
+		if len(sections[current].rows) == section.Rows {
+			return nil, &StrategyFileError{Line: lineNumber, Message: fmt.Sprintf("section [%s] has more than %d rows", section.Name, section.Rows)}
+		}
+
+		row, err := parseSectionRow(line, section, lineNumber, checkCharts)
+		if err != nil {
+			return nil, err
+		}
+
+		sections[current].rows = append(sections[current].rows, row)
+	}
+
+	raw := strategyHeader()
+	for index, section := range STRATEGY_SECTIONS {
+		rows := sections[index].rows
+
+		if len(rows) == 0 && section.Default != 0 {
+			raw = append(raw, bytes.Repeat([]byte{section.Default}, section.Length())...)
+			continue
+		}
+
+		if len(rows) != section.Rows {
+			line := lo.Ternary(sections[index].headerLine != 0, sections[index].headerLine, len(lines))
+			return nil, &StrategyFileError{Line: line, Message: fmt.Sprintf("section [%s] has %d rows, expected %d", section.Name, len(rows), section.Rows)}
+		}
+
+		raw = append(raw, bytes.Join(rows, nil)...)
+	}
+
+	return raw, nil
+}
+
+func parseSectionHeader(trimmed string, lineNumber int) (int, error) {
+	if !strings.HasSuffix(trimmed, "]") {
+		return 0, &StrategyFileError{Line: lineNumber, Column: len(trimmed), Message: "section header is missing a closing ]"}
+	}
+
+	name := strings.TrimSpace(trimmed[1 : len(trimmed)-1])
+	_, index, found := lo.FindIndexOf(STRATEGY_SECTIONS[:], func(section StrategySection) bool { return section.Name == name })
+	if !found {
+		return 0, &StrategyFileError{Line: lineNumber, Message: fmt.Sprintf("unknown section [%s]", name)}
+	}
+
+	return index, nil
+}
+
+func parseSectionRow(line string, section StrategySection, lineNumber int, checkCharts bool) ([]byte, error) {
+	isChart := section.RowLabels != nil && section.ColumnLabels != nil
+
+	cells := []strategyFileCell{}
+	for i := 0; i < len(line); i++ {
+		if line[i] == ' ' || line[i] == '\t' || line[i] == '\r' {
+			continue
+		}
+
+		cells = append(cells, strategyFileCell{value: line[i], column: i + 1})
+	}
+
+	for _, cell := range cells {
+		if (checkCharts || !isChart) && !bytes.ContainsRune(section.Alphabet, rune(cell.value)) {
+			return nil, &StrategyFileError{
+				Line:    lineNumber,
+				Column:  cell.column,
+				Message: fmt.Sprintf("%q is not allowed in section [%s], expected one of %q", cell.value, section.Name, section.Alphabet),
+			}
+		}
+	}
+
+	if len(cells) != section.Columns {
+		column := len(line) + 1
+		if len(cells) > section.Columns {
+			column = cells[section.Columns].column
+		}
+
+		return nil, &StrategyFileError{
+			Line:    lineNumber,
+			Column:  column,
+			Message: fmt.Sprintf("row in section [%s] has %d cells, expected %d", section.Name, len(cells), section.Columns),
+		}
+	}
+
+	return lo.Map(cells, func(cell strategyFileCell, _ int) byte { return cell.value }), nil
+}
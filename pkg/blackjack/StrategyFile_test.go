@@ -0,0 +1,209 @@
+package blackjack
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Helpers
+
+func legacyStrategyFile() string {
+	rows := []string{}
+	rows = append(rows, strings.Split(strings.Repeat("HHHHHHHHHH\n", PlayerHardHandCount), "\n")[:PlayerHardHandCount]...)
+	rows = append(rows, "")
+	rows = append(rows, strings.Split(strings.Repeat("SSSSSSSSSS\n", PlayerSoftHandCount), "\n")[:PlayerSoftHandCount]...)
+	rows = append(rows, "")
+	rows = append(rows, strings.Split(strings.Repeat("PPPPPPPPPP\n", PlayerPairHandCount), "\n")[:PlayerPairHandCount]...)
+	rows = append(rows, "", "03E8", "", "0001")
+
+	return strings.Join(rows, "\n")
+}
+
+// Tests
+
+func TestStrategyFileParsing(t *testing.T) {
+	t.Run("Should read a file without section headers", func(t *testing.T) {
+		strategy, err := ParseStrategyFile([]byte(legacyStrategyFile()))
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1000.0, strategy.GetInitialBankroll())
+		assert.Equal(t, 1, strategy.Bet())
+	})
+
+	t.Run("Should fill optional sections left out of the file", func(t *testing.T) {
+		strategy, _ := ParseStrategyFile([]byte(legacyStrategyFile()))
+
+		assert.Equal(t, 1, strategy.Spots(4))
+		assert.Equal(t, len(GetSequencing()), len(strategy.GetEncodedStrategy()))
+	})
+
+	t.Run("Should ignore comments and spaces between cells", func(t *testing.T) {
+		content := strings.Replace(legacyStrategyFile(), "03E8", "# bankroll\n0 3 E 8 # 1000", 1)
+		strategy, err := ParseStrategyFile([]byte(content))
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1000.0, strategy.GetInitialBankroll())
+	})
+
+	t.Run("Should read sections by header, in any order", func(t *testing.T) {
+		content := "[bet]\n0002\n" + strings.Replace(legacyStrategyFile(), "0001", "", 1)
+		content = strings.Replace(content, "HHHHHHHHHH\n", "[hard]\nHHHHHHHHHH\n", 1)
+		content = strings.Replace(content, "SSSSSSSSSS\n", "[soft]\nSSSSSSSSSS\n", 1)
+		content = strings.Replace(content, "PPPPPPPPPP\n", "[pair]\nPPPPPPPPPP\n", 1)
+		content = strings.Replace(content, "03E8", "[bankroll]\n03E8", 1)
+
+		strategy, err := ParseStrategyFile([]byte(content))
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, strategy.Bet())
+	})
+
+	t.Run("Should read back what it writes", func(t *testing.T) {
+		raw, err := getTestStrategy()
+		if err != nil {
+			t.Fatal(err)
+		}
+		strategy, _ := NewStrategy(raw)
+
+		parsed, err := ParseStrategyFile(FormatStrategyFile(strategy))
+
+		assert.NoError(t, err)
+		assert.Equal(t, raw, parsed.GetEncodedStrategy())
+	})
+}
+
+func TestStrategyFileErrors(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		content string
+		want    string
+	}{
+		{
+			desc:    "Should point at a cell outside the section alphabet",
+			content: strings.Replace(legacyStrategyFile(), "HHHHHHHHHH", "HHHXHHHHHH", 1),
+			want:    "line 1, column 4: 'X' is not allowed in section [hard], expected one of \"HSD\"",
+		},
+		{
+			desc:    "Should point at the end of a short row",
+			content: strings.Replace(legacyStrategyFile(), "HHHHHHHHHH", "HHHHHHHHH", 1),
+			want:    "line 1, column 10: row in section [hard] has 9 cells, expected 10",
+		},
+		{
+			desc:    "Should point at the first extra cell of a long row",
+			content: strings.Replace(legacyStrategyFile(), "HHHHHHHHHH", "HHHHHHHHHHH", 1),
+			want:    "line 1, column 11: row in section [hard] has 11 cells, expected 10",
+		},
+		{
+			desc:    "Should reject unknown sections",
+			content: "[surrender]\n",
+			want:    "line 1: unknown section [surrender]",
+		},
+		{
+			desc:    "Should reject a section header without a closing bracket",
+			content: "[hard\n",
+			want:    "line 1, column 5: section header is missing a closing ]",
+		},
+		{
+			desc:    "Should reject a section that appears twice",
+			content: "[bet]\n0001\n[bet]\n0001\n",
+			want:    "line 3: section [bet] appears twice",
+		},
+		{
+			desc:    "Should reject a section with too many rows",
+			content: "[bet]\n0001\n0001\n",
+			want:    "line 3: section [bet] has more than 1 rows",
+		},
+		{
+			desc:    "Should reject a required section with missing rows",
+			content: "[hard]\nHHHHHHHHHH\n",
+			want:    "line 1: section [hard] has 1 rows, expected 16",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.desc, func(t *testing.T) {
+			_, err := ParseStrategyFile([]byte(testCase.content))
+
+			var fileErr *StrategyFileError
+			assert.ErrorAs(t, err, &fileErr)
+			assert.EqualError(t, err, testCase.want)
+		})
+	}
+
+	t.Run("Should reject rows past the last section", func(t *testing.T) {
+		raw, err := getTestStrategy()
+		if err != nil {
+			t.Fatal(err)
+		}
+		strategy, _ := NewStrategy(raw)
+
+		content := append(FormatStrategyFile(strategy), []byte("----------\n")...)
+		content = []byte(strings.ReplaceAll(string(content), "[", "# ["))
+		lineCount := strings.Count(string(content), "\n")
+
+		_, err = ParseStrategyFile(content)
+		assert.EqualError(t, err, fmt.Sprintf("line %d: unexpected row, every section is complete", lineCount))
+	})
+}
+
+func TestStrategyFileSaving(t *testing.T) {
+	raw, err := getTestStrategy()
+	if err != nil {
+		t.Fatal(err)
+	}
+	strategy, _ := NewStrategy(raw)
+
+	t.Run("Should save a strategy that loads back the same", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "saved.strategy")
+
+		err := SaveStrategyFile(path, strategy)
+		assert.NoError(t, err)
+
+		loaded, err := LoadStrategyFile(path)
+		assert.NoError(t, err)
+		assert.Equal(t, raw, loaded.GetEncodedStrategy())
+	})
+
+	t.Run("Should write one section header per section", func(t *testing.T) {
+		content := string(FormatStrategyFile(strategy))
+
+		for _, section := range STRATEGY_SECTIONS {
+			assert.Contains(t, content, "["+section.Name+"]\n")
+		}
+	})
+
+	t.Run("Should load chart cells that can't be played as they are", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "impossible.strategy")
+		firstRow := string(sectionRows(raw, STRATEGY_SECTIONS[0])[0])
+		content := strings.Replace(string(FormatStrategyFile(strategy)), "[hard]\n"+firstRow, "[hard]\nPPPPPPPPPP", 1)
+		os.WriteFile(path, []byte(content), 0644)
+
+		_, err := LoadStrategyFile(path)
+		assert.Error(t, err)
+
+		loaded, err := LoadRawStrategyFile(path)
+		assert.NoError(t, err)
+		assert.Equal(t, byte('P'), loaded[sectionOffset("hard")])
+	})
+
+	t.Run("Should still check the cells outside the charts", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "broken.strategy")
+		os.WriteFile(path, []byte("[bet]\n00X1\n"), 0644)
+
+		_, err := LoadRawStrategyFile(path)
+		assert.EqualError(t, err, path+": line 2, column 3: 'X' is not allowed in section [bet], expected one of \"0123456789ABCDEF\"")
+	})
+
+	t.Run("Should name the file in parse errors", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "broken.strategy")
+		os.WriteFile(path, []byte("[surrender]\n"), 0644)
+
+		_, err := LoadStrategyFile(path)
+		assert.EqualError(t, err, path+": line 1: unknown section [surrender]")
+	})
+}
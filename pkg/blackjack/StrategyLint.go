@@ -0,0 +1,261 @@
+package blackjack
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+)
+
+type LintSeverity string
+
+const (
+	LintError   LintSeverity = "error"
+	LintWarning LintSeverity = "warning"
+)
+
+type LintRule string
+
+const (
+	// The action can't be taken with that hand at all
+	ImpossibleAction LintRule = "impossible-action"
+	// The action is never right, whatever the dealer shows
+	NeverSensible LintRule = "never-sensible"
+	// The cell breaks the pattern of the dealer upcards around it
+	RowPattern LintRule = "row-pattern"
+	// Hitting a total after standing on a lower one against the same upcard
+	ColumnMonotonicity LintRule = "column-monotonicity"
+)
+
+type LintFinding struct {
+	Severity LintSeverity
+	Rule     LintRule
+	Section  string
+	Hand     string
+	Dealer   string
+	Message  string
+}
+
+func (finding LintFinding) String() string {
+	return fmt.Sprintf("%s: [%s] %s vs %s: %s (%s)", finding.Severity, finding.Section, finding.Hand, finding.Dealer, finding.Message, finding.Rule)
+}
+
+type lintCell struct {
+	section StrategySection
+	row     int
+	column  int
+	action  PlayerAction
+}
+
+// Public methods
+
+// Lints a raw strategy of any version, raw so the cells a Strategy refuses to hold are reported too;
+// fails only when the strategy can't be decoded
+func LintStrategy(raw []byte) ([]LintFinding, error) {
+	upgraded, err := UpgradeStrategy(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	findings := []LintFinding{}
+	for _, section := range chartSections() {
+		rows := sectionRows(upgraded, section)
+
+		findings = append(findings, lintImpossibleActions(section, rows)...)
+		findings = append(findings, lintNeverSensible(section, rows)...)
+		findings = append(findings, lintRowPattern(section, rows)...)
+		findings = append(findings, lintColumnMonotonicity(section, rows)...)
+	}
+
+	return findings, nil
+}
+
+// Whether a gene of the sequencing may hold a value, ruling out the plays that are never sensible
+func IsSensibleGene(gene int, base byte) bool {
+	section, index, ok := sectionAt(gene)
+	if !ok || section.RowLabels == nil || section.ColumnLabels == nil {
+		return true
+	}
+
+	return neverSensibleReason(section, section.RowLabels[index/section.Columns], PlayerAction(base)) == ""
+}
+
+// Private methods
+
+func lintImpossibleActions(section StrategySection, rows [][]byte) []LintFinding {
+	findings := []LintFinding{}
+	eachLintCell(section, rows, func(cell lintCell) {
+		if bytes.IndexByte(section.Alphabet, byte(cell.action[0])) >= 0 {
+			return
+		}
+
+		message := fmt.Sprintf("%q can't be played here, expected one of %q", cell.action, section.Alphabet)
+		if cell.action == SplitOrHit || cell.action == SplitOrStand {
+			message = "only pairs can be split"
+		}
+		findings = append(findings, cell.finding(LintError, ImpossibleAction, message))
+	})
+
+	return findings
+}
+
+func lintNeverSensible(section StrategySection, rows [][]byte) []LintFinding {
+	findings := []LintFinding{}
+	eachLintCell(section, rows, func(cell lintCell) {
+		message := neverSensibleReason(section, cell.hand(), cell.action)
+		if message != "" {
+			findings = append(findings, cell.finding(LintWarning, NeverSensible, message))
+		}
+	})
+
+	return findings
+}
+
+func lintRowPattern(section StrategySection, rows [][]byte) []LintFinding {
+	if !isTotalSection(section) {
+		return []LintFinding{}
+	}
+
+	findings := []LintFinding{}
+	for row, cells := range rows {
+		for column := 1; column < len(cells)-1; column++ {
+			previous, current, next := cells[column-1], cells[column], cells[column+1]
+			if previous != next || current == previous || previous == byte(NoOverride[0]) || current == byte(NoOverride[0]) {
+				continue
+			}
+
+			cell := lintCell{section, row, column, PlayerAction(current)}
+			message := fmt.Sprintf("%s between %s against %s and %s", actionName(cell.action), actionName(PlayerAction(previous)), section.ColumnLabels[column-1], section.ColumnLabels[column+1])
+			findings = append(findings, cell.finding(LintWarning, RowPattern, message))
+		}
+	}
+
+	return findings
+}
+
+func lintColumnMonotonicity(section StrategySection, rows [][]byte) []LintFinding {
+	if !isTotalSection(section) {
+		return []LintFinding{}
+	}
+
+	findings := []LintFinding{}
+	for column := range section.ColumnLabels {
+		stoodOn := ""
+		for row := range rows {
+			action := PlayerAction(rows[row][column])
+
+			if action == Stand && stoodOn == "" {
+				stoodOn = section.RowLabels[row]
+				continue
+			}
+
+			if action == Hit && stoodOn != "" {
+				cell := lintCell{section, row, column, action}
+				findings = append(findings, cell.finding(LintWarning, ColumnMonotonicity, fmt.Sprintf("hits after standing on %s", stoodOn)))
+			}
+		}
+	}
+
+	return findings
+}
+
+func (cell lintCell) hand() string {
+	return cell.section.RowLabels[cell.row]
+}
+
+func (cell lintCell) finding(severity LintSeverity, rule LintRule, message string) LintFinding {
+	return LintFinding{
+		Severity: severity,
+		Rule:     rule,
+		Section:  cell.section.Name,
+		Hand:     cell.hand(),
+		Dealer:   cell.section.ColumnLabels[cell.column],
+		Message:  message,
+	}
+}
+
+// Helper methods
+
+func eachLintCell(section StrategySection, rows [][]byte, callback func(cell lintCell)) {
+	for row, cells := range rows {
+		for column, value := range cells {
+			callback(lintCell{section, row, column, PlayerAction(value)})
+		}
+	}
+}
+
+func isTotalSection(section StrategySection) bool {
+	category, ok := lintHandType(section)
+	return ok && category != PairHand
+}
+
+// The hand type a section is played for, if it is a chart of a single hand type
+func lintHandType(section StrategySection) (HandCategory, bool) {
+	switch section.Name {
+	case "hard", "split-hard":
+		return HardHand, true
+	case "soft", "split-soft":
+		return SoftHand, true
+	case "pair", "split-pair":
+		return PairHand, true
+	default:
+		return HardHand, false
+	}
+}
+
+func neverSensibleReason(section StrategySection, hand string, action PlayerAction) string {
+	category, ok := lintHandType(section)
+	if !ok {
+		return ""
+	}
+
+	switch category {
+	case HardHand:
+		total, _ := strconv.Atoi(hand)
+		if total >= 17 && (action == Hit || action == Double) {
+			return fmt.Sprintf("%s hard %d", actionVerb(action), total)
+		}
+		if total <= 11 && action == Stand {
+			return fmt.Sprintf("standing on hard %d, when hitting can't bust", total)
+		}
+	case SoftHand:
+		total, _ := strconv.Atoi(hand)
+		if total == 20 && (action == Hit || action == Double) {
+			return fmt.Sprintf("%s soft 20", actionVerb(action))
+		}
+		if total <= 16 && action == Stand {
+			return fmt.Sprintf("standing on soft %d, when hitting can't bust", total)
+		}
+	case PairHand:
+		if hand == "10-10" && action == SplitOrHit {
+			return "splitting tens"
+		}
+		if hand == "5-5" && action == SplitOrHit {
+			return "splitting fives"
+		}
+		if hand == "A-A" && action == Stand {
+			return "standing on a pair of aces"
+		}
+	}
+
+	return ""
+}
+
+func actionVerb(action PlayerAction) string {
+	switch action {
+	case Hit:
+		return "hitting"
+	case Double:
+		return "doubling"
+	default:
+		return actionName(action)
+	}
+}
+
+func actionName(action PlayerAction) string {
+	style, ok := ACTION_STYLES[action]
+	if !ok {
+		return string(action)
+	}
+
+	return style.Name
+}
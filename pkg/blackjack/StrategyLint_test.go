@@ -0,0 +1,162 @@
+package blackjack
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+// Helpers
+
+func setStrategyCell(raw []byte, section string, row int, column int, action byte) []byte {
+	changed := append([]byte{}, raw...)
+	changed[sectionOffset(section)+row*DealerHandCount+column] = action
+
+	return changed
+}
+
+// Tests
+
+func TestStrategyLint(t *testing.T) {
+	raw, err := getTestStrategy()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hard := func(total int) int { return total - 5 }
+	soft := func(total int) int { return total - 13 }
+
+	testCases := []struct {
+		desc string
+		raw  []byte
+		want LintFinding
+	}{
+		{
+			desc: "Should flag hitting hard 18",
+			raw:  setStrategyCell(raw, "hard", hard(18), 4, 'H'),
+			want: LintFinding{LintWarning, NeverSensible, "hard", "18", "6", "hitting hard 18"},
+		},
+		{
+			desc: "Should flag standing on a total that can't bust",
+			raw:  setStrategyCell(raw, "hard", hard(10), 9, 'S'),
+			want: LintFinding{LintWarning, NeverSensible, "hard", "10", "A", "standing on hard 10, when hitting can't bust"},
+		},
+		{
+			desc: "Should flag doubling soft 20",
+			raw:  setStrategyCell(raw, "soft", soft(20), 4, 'D'),
+			want: LintFinding{LintWarning, NeverSensible, "soft", "20", "6", "doubling soft 20"},
+		},
+		{
+			desc: "Should flag splitting tens",
+			raw:  setStrategyCell(raw, "pair", 8, 9, 'P'),
+			want: LintFinding{LintWarning, NeverSensible, "pair", "10-10", "A", "splitting tens"},
+		},
+		{
+			desc: "Should flag never-sensible plays after a split",
+			raw:  setStrategyCell(raw, "split-hard", hard(19), 0, 'H'),
+			want: LintFinding{LintWarning, NeverSensible, "split-hard", "19", "2", "hitting hard 19"},
+		},
+		{
+			desc: "Should flag a cell breaking the pattern of its row",
+			raw:  setStrategyCell(raw, "hard", hard(14), 2, 'H'),
+			want: LintFinding{LintWarning, RowPattern, "hard", "14", "4", "Hit between Stand against 3 and 5"},
+		},
+		{
+			desc: "Should flag hitting after standing on a lower total",
+			raw:  setStrategyCell(raw, "hard", hard(15), 0, 'H'),
+			want: LintFinding{LintWarning, ColumnMonotonicity, "hard", "15", "2", "hits after standing on 13"},
+		},
+		{
+			desc: "Should flag splitting a hand that is not a pair",
+			raw:  setStrategyCell(raw, "hard", hard(12), 3, 'P'),
+			want: LintFinding{LintError, ImpossibleAction, "hard", "12", "5", "only pairs can be split"},
+		},
+		{
+			desc: "Should flag doubling with three cards or more",
+			raw:  setStrategyCell(raw, "card-count", 0, 0, 'D'),
+			want: LintFinding{LintError, ImpossibleAction, "card-count", "12 (3 cards)", "2", "\"D\" can't be played here, expected one of \"-HS\""},
+		},
+	}
+
+	t.Run("Should find nothing wrong with basic strategy", func(t *testing.T) {
+		findings, err := LintStrategy(raw)
+
+		assert.NoError(t, err)
+		assert.Empty(t, findings)
+	})
+
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			findings, err := LintStrategy(tC.raw)
+
+			assert.NoError(t, err)
+			assert.Contains(t, findings, tC.want)
+		})
+	}
+
+	t.Run("Should lint legacy strategies", func(t *testing.T) {
+		legacy := legacyStrategy(raw, 4)
+		legacy[hard(18)*DealerHandCount] = 'H'
+
+		findings, err := LintStrategy(legacy)
+
+		assert.NoError(t, err)
+		assert.True(t, lo.ContainsBy(findings, func(finding LintFinding) bool {
+			return finding.Rule == NeverSensible && finding.Hand == "18"
+		}))
+	})
+
+	t.Run("Should fail on a strategy that can't be decoded", func(t *testing.T) {
+		_, err := LintStrategy([]byte("HHH"))
+
+		assert.Error(t, err)
+	})
+
+	t.Run("Should fail on a corrupt header", func(t *testing.T) {
+		corrupt := append([]byte{}, raw...)
+		copy(corrupt[len(strategyMagic):], "ZZ")
+
+		_, err := LintStrategy(corrupt)
+
+		assert.ErrorContains(t, err, "invalid strategy header")
+	})
+
+	t.Run("Should print severity, coordinates, message and rule", func(t *testing.T) {
+		finding := LintFinding{LintWarning, NeverSensible, "hard", "18", "6", "hitting hard 18"}
+
+		assert.Equal(t, "warning: [hard] 18 vs 6: hitting hard 18 (never-sensible)", finding.String())
+	})
+}
+
+func TestIsSensibleGene(t *testing.T) {
+	hard20Vs6 := sectionOffset("hard") + int(HardTwenty-HardFive)*DealerHandCount + int(DealerSix-DealerTwo)
+	pair10Vs2 := sectionOffset("pair") + 8*DealerHandCount
+
+	testCases := []struct {
+		desc string
+		gene int
+		base byte
+		want bool
+	}{
+		{desc: "Should rule out hitting hard 20", gene: hard20Vs6, base: 'H', want: false},
+		{desc: "Should allow standing on hard 20", gene: hard20Vs6, base: 'S', want: true},
+		{desc: "Should rule out splitting tens", gene: pair10Vs2, base: 'P', want: false},
+		{desc: "Should allow anything in the header", gene: 0, base: 'B', want: true},
+		{desc: "Should allow anything in the bet ramp", gene: sectionOffset("bet"), base: 'F', want: true},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			assert.Equal(t, tC.want, IsSensibleGene(tC.gene, tC.base))
+		})
+	}
+
+	t.Run("Should leave at least one base for every gene", func(t *testing.T) {
+		for gene, bases := range GetSequencing() {
+			allowed := lo.Filter(bases, func(base byte, _ int) bool { return IsSensibleGene(gene, base) })
+
+			assert.NotEmpty(t, allowed, "gene %d", gene)
+		}
+	})
+}
@@ -5,34 +5,12 @@ import (
 	"os"
 	"testing"
 
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 )
 
 // Helpers
 
-func openTextFile(filePath string) ([]byte, error) {
-	file, err := os.Open(filePath)
-	if err != nil {
-		return nil, err
-	}
-	defer file.Close()
-
-	fileInfo, err := file.Stat()
-	if err != nil {
-		return nil, err
-	}
-
-	fileSize := fileInfo.Size()
-	fileContent := make([]byte, fileSize)
-
-	_, err = file.Read(fileContent)
-	if err != nil {
-		return nil, err
-	}
-
-	return fileContent, nil
-}
-
 func getTestStrategy() ([]byte, error) {
 	cwd, cwdErr := os.Getwd()
 	if cwdErr != nil {
@@ -40,17 +18,13 @@ func getTestStrategy() ([]byte, error) {
 	}
 
 	testStrategyFileName := cwd + "/__mock_data__/strategies/ideal.strategy"
-	raw, readError := openTextFile(testStrategyFileName)
+	strategy, loadError := LoadStrategyFile(testStrategyFileName)
 
-	if readError != nil {
-		return nil, readError
+	if loadError != nil {
+		return nil, loadError
 	}
 
-	// Remove line breaks and spaces
-	noLineBreaks := bytes.ReplaceAll(raw, []byte("\n"), []byte(""))
-	noSpaces := bytes.ReplaceAll(noLineBreaks, []byte(" "), []byte(""))
-
-	return noSpaces, nil
+	return strategy.GetEncodedStrategy(), nil
 }
 
 // Tests
@@ -98,6 +72,12 @@ func TestStrategyActionParsing(t *testing.T) {
 			dealerHand:     Hand{NewCard(Seven, Clubs)},
 			expectedAction: Stand,
 		},
+		{
+			desc:           "Should handle a pair with more cards as a hard hand",
+			playerHand:     Hand{NewCard(Two, Clubs), NewCard(Two, Hearts), NewCard(Five, Hearts)},
+			dealerHand:     Hand{NewCard(Five, Clubs)},
+			expectedAction: Double,
+		},
 		{
 			desc:           "Should handle two aces",
 			playerHand:     Hand{NewCard(Ace, Spades), NewCard(Ace, Hearts)},
@@ -108,13 +88,136 @@ func TestStrategyActionParsing(t *testing.T) {
 
 	for _, testCase := range testCases {
 		t.Run(testCase.desc, func(t *testing.T) {
-			got := strategy.Play(testCase.playerHand, testCase.dealerHand)
+			got := strategy.Play(testCase.playerHand, testCase.dealerHand, PlayContext{})
+			want := testCase.expectedAction
+			assert.Equal(t, want, got)
+		})
+	}
+}
+
+func TestStrategyCompositionOverrides(t *testing.T) {
+	raw, err := getTestStrategy()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 10-2 vs 4 hits, 7-5 vs 4 keeps the total cell
+	twoCardComboStartsAt := sectionOffset("two-card-combo")
+	raw[twoCardComboStartsAt+2] = 'H'
+	// 4+ card 12 vs 2 stands
+	fourCardsStartsAt := sectionOffset("card-count") + CompositionHardHandCount*DealerHandCount
+	raw[fourCardsStartsAt] = 'S'
+
+	strategy, err := NewStrategy(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		desc           string
+		playerHand     Hand
+		dealerHand     Hand
+		expectedAction PlayerAction
+	}{
+		{
+			desc:           "Should stand on a 3 card 16 vs 10",
+			playerHand:     Hand{NewCard(Four, Clubs), NewCard(Five, Clubs), NewCard(Seven, Clubs)},
+			dealerHand:     Hand{NewCard(Ten, Clubs)},
+			expectedAction: Stand,
+		},
+		{
+			desc:           "Should stand on a 4 card 16 vs 10",
+			playerHand:     Hand{NewCard(Four, Clubs), NewCard(Five, Clubs), NewCard(Four, Hearts), NewCard(Three, Clubs)},
+			dealerHand:     Hand{NewCard(Queen, Clubs)},
+			expectedAction: Stand,
+		},
+		{
+			desc:           "Should hit a 2 card 16 vs 10",
+			playerHand:     Hand{NewCard(Ten, Clubs), NewCard(Six, Clubs)},
+			dealerHand:     Hand{NewCard(Ten, Hearts)},
+			expectedAction: Hit,
+		},
+		{
+			desc:           "Should use the two card combination",
+			playerHand:     Hand{NewCard(Queen, Clubs), NewCard(Two, Clubs)},
+			dealerHand:     Hand{NewCard(Four, Hearts)},
+			expectedAction: Hit,
+		},
+		{
+			desc:           "Should use the two card combination regardless of the order",
+			playerHand:     Hand{NewCard(Two, Clubs), NewCard(King, Clubs)},
+			dealerHand:     Hand{NewCard(Four, Hearts)},
+			expectedAction: Hit,
+		},
+		{
+			desc:           "Should defer to the total when the combination has no override",
+			playerHand:     Hand{NewCard(Seven, Clubs), NewCard(Five, Clubs)},
+			dealerHand:     Hand{NewCard(Four, Hearts)},
+			expectedAction: Stand,
+		},
+		{
+			desc:           "Should use the card count bucket for 4 or more cards",
+			playerHand:     Hand{NewCard(Two, Clubs), NewCard(Three, Clubs), NewCard(Three, Hearts), NewCard(Four, Clubs)},
+			dealerHand:     Hand{NewCard(Two, Hearts)},
+			expectedAction: Stand,
+		},
+		{
+			desc:           "Should defer to the total for 3 cards when only 4 cards have an override",
+			playerHand:     Hand{NewCard(Two, Clubs), NewCard(Six, Clubs), NewCard(Four, Clubs)},
+			dealerHand:     Hand{NewCard(Two, Hearts)},
+			expectedAction: Hit,
+		},
+		{
+			desc:           "Should not use overrides outside hard 12 to 16",
+			playerHand:     Hand{NewCard(Ace, Clubs), NewCard(Five, Clubs)},
+			dealerHand:     Hand{NewCard(Ten, Hearts)},
+			expectedAction: Hit,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.desc, func(t *testing.T) {
+			got := strategy.Play(testCase.playerHand, testCase.dealerHand, PlayContext{})
 			want := testCase.expectedAction
 			assert.Equal(t, want, got)
 		})
 	}
 }
 
+func TestStrategyPostSplit(t *testing.T) {
+	raw, err := getTestStrategy()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// hard 11 vs 10: double after split in the ideal chart, hit in this one
+	postSplitStartsAt := sectionOffset("split-hard")
+	hard11Vs10 := postSplitStartsAt + int(HardEleven-HardFive)*DealerHandCount + int(DealerTen-DealerTwo)
+	raw[hard11Vs10] = 'H'
+	strategy, _ := NewStrategy(raw)
+
+	playerHand := Hand{NewCard(Eight, Clubs), NewCard(Three, Hearts)}
+	dealerHand := Hand{NewCard(King, Clubs)}
+
+	t.Run("Should use the post-split cell for split hands", func(t *testing.T) {
+		got := strategy.Play(playerHand, dealerHand, PlayContext{IsSplit: true})
+		assert.Equal(t, Hit, got)
+	})
+
+	t.Run("Should ignore the post-split cell for original hands", func(t *testing.T) {
+		got := strategy.Play(playerHand, dealerHand, PlayContext{})
+		assert.Equal(t, Double, got)
+	})
+
+	t.Run("Should fall back to the main chart when there is no post-split cell", func(t *testing.T) {
+		softHand := Hand{NewCard(Ace, Clubs), NewCard(Six, Hearts)}
+		dealerHand := Hand{NewCard(Five, Clubs)}
+
+		got := strategy.Play(softHand, dealerHand, PlayContext{IsSplit: true})
+		assert.Equal(t, Double, got)
+	})
+}
+
 func TestStrategyPlayMapping(t *testing.T) {
 	raw, err := getTestStrategy()
 	if err != nil {
@@ -126,7 +229,7 @@ func TestStrategyPlayMapping(t *testing.T) {
 		playerHand := Hand{NewCard(King, Spades), NewCard(King, Hearts), NewCard(King, Diamonds)}
 		dealerHand := Hand{NewCard(Two, Clubs)}
 
-		got := strategy.Play(playerHand, dealerHand)
+		got := strategy.Play(playerHand, dealerHand, PlayContext{})
 		want := Stand
 		assert.Equal(t, want, got)
 	})
@@ -135,7 +238,7 @@ func TestStrategyPlayMapping(t *testing.T) {
 		playerHand := Hand{NewCard(King, Spades), NewCard(Ace, Hearts)}
 		dealerHand := Hand{NewCard(Two, Clubs)}
 
-		got := strategy.Play(playerHand, dealerHand)
+		got := strategy.Play(playerHand, dealerHand, PlayContext{})
 		want := Stand
 		assert.Equal(t, want, got)
 	})
@@ -169,12 +272,92 @@ func TestStrategyBetParsing(t *testing.T) {
 	})
 }
 
+func TestStrategySpotsParsing(t *testing.T) {
+	raw, err := getTestStrategy()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	spotsStartsAt := sectionOffset("spots")
+	copy(raw[spotsStartsAt:], []byte("11233"))
+	strategy, _ := NewStrategy(raw)
+
+	testCases := []struct {
+		desc      string
+		trueCount int
+		want      int
+	}{
+		{desc: "Should use the first bucket for negative counts", trueCount: -3, want: 1},
+		{desc: "Should use the first bucket for a zero count", trueCount: 0, want: 1},
+		{desc: "Should use a bucket per true count", trueCount: 2, want: 2},
+		{desc: "Should use the last bucket for high counts", trueCount: 9, want: 3},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.desc, func(t *testing.T) {
+			got := strategy.Spots(testCase.trueCount)
+			assert.Equal(t, testCase.want, got)
+		})
+	}
+}
+
 func TestStrategyParsingErrors(t *testing.T) {
 	t.Run("Should handle invalid strategy length", func(t *testing.T) {
 		raw := bytes.Repeat([]byte("H"), 1)
 		_, err := NewStrategy(raw)
 		assert.Error(t, err)
 	})
+
+	t.Run("Should accept every value published by GetSequencing", func(t *testing.T) {
+		sequence := GetSequencing()
+		first := lo.Map(sequence, func(alphabet []byte, _ int) byte { return alphabet[0] })
+		last := lo.Map(sequence, func(alphabet []byte, _ int) byte { return alphabet[len(alphabet)-1] })
+
+		_, firstErr := NewStrategy(first)
+		_, lastErr := NewStrategy(last)
+
+		assert.NoError(t, firstErr)
+		assert.NoError(t, lastErr)
+	})
+
+	raw, err := getTestStrategy()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hard12Vs4 := int(HardTwelve-HardFive)*DealerHandCount + int(DealerFour-DealerTwo)
+	pairAcesVsAce := sectionOffset("bankroll") - 1
+	bankrollFirstDigit := sectionOffset("bankroll")
+	spotsTrueCountTwo := sectionOffset("spots") + 2
+
+	broken := append([]byte{}, raw...)
+	broken[sectionOffset("hard")+hard12Vs4] = 'X'
+	broken[pairAcesVsAce] = 'Q'
+	broken[bankrollFirstDigit] = 'G'
+	broken[spotsTrueCountTwo] = '7'
+
+	t.Run("Should list every cell outside its section alphabet", func(t *testing.T) {
+		_, err := NewStrategy(broken)
+
+		var invalid *InvalidStrategyError
+		assert.ErrorAs(t, err, &invalid)
+		assert.Equal(t, []InvalidCell{
+			{Section: "hard", Index: hard12Vs4, Hand: "12", Dealer: "4", Value: 'X', Allowed: []byte("HSD")},
+			{Section: "pair", Index: PlayerPairHandCount*DealerHandCount - 1, Hand: "A-A", Dealer: "A", Value: 'Q', Allowed: []byte("HSDP")},
+			{Section: "bankroll", Index: 0, Hand: "", Dealer: "", Value: 'G', Allowed: []byte("0123456789ABCDEF")},
+			{Section: "spots", Index: 2, Hand: "", Dealer: "TC 2", Value: '7', Allowed: []byte("123")},
+		}, invalid.Cells)
+	})
+
+	t.Run("Should describe every bad cell in the error message", func(t *testing.T) {
+		_, err := NewStrategy(broken)
+
+		assert.EqualError(t, err, "invalid strategy, 4 bad cells: "+
+			"hard 12 vs 4 is 'X', expected one of \"HSD\"; "+
+			"pair A-A vs A is 'Q', expected one of \"HSDP\"; "+
+			"bankroll digit 1 is 'G', expected one of \"0123456789ABCDEF\"; "+
+			"spots TC 2 is '7', expected one of \"123\"")
+	})
 }
 
 func TestStrategyReturnEncodedStrategy(t *testing.T) {
@@ -194,6 +377,104 @@ func TestStrategyReturnEncodedStrategy(t *testing.T) {
 func TestStrategySequencing(t *testing.T) {
 	t.Run("Should the encoding sequence for strategies", func(t *testing.T) {
 		sequence := GetSequencing()
-		assert.Len(t, sequence, 348)
+		assert.Len(t, sequence, strategyHeaderLength+954)
+	})
+
+	t.Run("Should end with the mutation rate gene", func(t *testing.T) {
+		sequence := GetSequencing()
+		assert.Equal(t, findSection("mutation-rate").Alphabet, sequence[len(sequence)-1])
 	})
 }
+
+func TestFreezeSections(t *testing.T) {
+	strategy := getTestStrategyValue(t)
+	raw := strategy.GetEncodedStrategy()
+
+	t.Run("Should hold the named sections at the values of the strategy", func(t *testing.T) {
+		mask, err := FreezeSections(strategy, []string{"hard", "bet"})
+		assert.NoError(t, err)
+
+		hard := findSection("hard")
+		bet := findSection("bet")
+
+		assert.Len(t, mask, len(GetSequencing()))
+		assert.Equal(t, sectionCells(raw, "hard"), mask[sectionOffset("hard"):sectionOffset("hard")+hard.Length()])
+		assert.Equal(t, sectionCells(raw, "bet"), mask[sectionOffset("bet"):sectionOffset("bet")+bet.Length()])
+	})
+
+	t.Run("Should leave every other gene free", func(t *testing.T) {
+		mask, _ := FreezeSections(strategy, []string{"hard"})
+
+		frozen := lo.CountBy(mask, func(value byte) bool { return value != 0 })
+
+		assert.Equal(t, findSection("hard").Length(), frozen)
+	})
+
+	t.Run("Should fail on an unknown section", func(t *testing.T) {
+		_, err := FreezeSections(strategy, []string{"insurance"})
+
+		assert.EqualError(t, err, "unknown strategy section [insurance]")
+	})
+}
+
+func TestStrategyBlocks(t *testing.T) {
+	sequencing := GetSequencing()
+
+	t.Run("Should cover every gene but the header once, section by section", func(t *testing.T) {
+		blocks := SectionBlocks()
+
+		assert.Len(t, blocks, len(STRATEGY_SECTIONS))
+		assert.Equal(t, GeneBlock{"hard", "hard", strategyHeaderLength, 160, "hard", 0}, blocks[0])
+		assert.Equal(t, len(sequencing)-strategyHeaderLength, lo.SumBy(blocks, func(block GeneBlock) int { return block.Length }))
+	})
+
+	t.Run("Should list the numeric sections", func(t *testing.T) {
+		blocks := NumericBlocks()
+
+		assert.Equal(t, []string{"bankroll", "bet", "spots"}, lo.Map(blocks, func(block GeneBlock, _ int) string { return block.Section }))
+	})
+
+	t.Run("Should split the charts by row", func(t *testing.T) {
+		blocks := RowBlocks()
+
+		hard12, _ := lo.Find(blocks, func(block GeneBlock) bool { return block.Section == "hard" && block.Label == "12" })
+		bet, _ := lo.Find(blocks, func(block GeneBlock) bool { return block.Section == "bet" })
+
+		assert.Equal(t, GeneBlock{"hard", "12", sectionOffset("hard") + 7*DealerHandCount, DealerHandCount, "hard", 12}, hard12)
+		assert.Equal(t, GeneBlock{"bet", "bet", sectionOffset("bet"), 4, "bet", 0}, bet)
+		assert.Equal(t, len(sequencing)-strategyHeaderLength, lo.SumBy(blocks, func(block GeneBlock) int { return block.Length }))
+	})
+
+	t.Run("Should only give neighbours to rows of one hand total and number of cards", func(t *testing.T) {
+		blocks := lo.KeyBy(RowBlocks(), func(block GeneBlock) string { return block.Section + " " + block.Label })
+
+		assert.Equal(t, []int{15, 16}, []int{blocks["soft 15"].Total, blocks["split-hard 16"].Total})
+		assert.Equal(t, "card-count (3 cards)", blocks["card-count 16 (3 cards)"].Group)
+		assert.Equal(t, "card-count (4+ cards)", blocks["card-count 12 (4+ cards)"].Group)
+		assert.Equal(t, GeneBlock{"pair", "8-8", sectionOffset("pair") + 6*DealerHandCount, DealerHandCount, "pair", 0}, blocks["pair 8-8"])
+		assert.Equal(t, GeneBlock{"two-card-combo", "10-2", sectionOffset("two-card-combo"), DealerHandCount, "two-card-combo", 0}, blocks["two-card-combo 10-2"])
+	})
+}
+
+func TestSpotSpread(t *testing.T) {
+	testCases := []struct {
+		desc  string
+		spots []int
+		want  float64
+	}{
+		{desc: "Should be 0 when playing the same spots at every count", spots: []int{2, 2, 2, 2, 2}, want: 0},
+		{desc: "Should be halfway with one spot more at high counts", spots: []int{2, 2, 2, 3, 3}, want: 0.5},
+		{desc: "Should be 1 when going from a single spot to the most", spots: []int{1, 1, 2, 3, 3}, want: 1},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.desc, func(t *testing.T) {
+			strategy := &strategyMock{}
+			for trueCount, spots := range testCase.spots {
+				strategy.On("Spots", trueCount).Return(spots)
+			}
+
+			assert.Equal(t, testCase.want, SpotSpread(strategy))
+		})
+	}
+}
@@ -1,30 +1,83 @@
 package blackjack
 
 import (
+	"math"
+
 	"github.com/samber/lo"
 )
 
 type Table struct {
 	Players []Playerish
 	Shoe    Shoeish
+	Options TableOptions
+}
+
+type TableOptions struct {
+	SideBetPayTables SideBetPayTables
+
+	// 0 means there is no limit
+	MinimumBet int
+	MaximumBet int
+	// Bets and payouts are rounded down to the smallest denomination, none means no rounding
+	ChipDenominations []float64
+	BetLimitPolicy    BetLimitPolicy
+
+	DoubleAfterSplit bool
+	// Hands a single spot can be split into, 0 means there is no limit
+	MaxSplitHands int
 }
 
+type BetLimitPolicy int
+
+const (
+	RefuseOutOfLimitBets BetLimitPolicy = iota
+	ClampOutOfLimitBets
+)
+
+type BetLimitOutcome int
+
+const (
+	BetAccepted BetLimitOutcome = iota
+	BetClamped
+	BetRefused
+)
+
 // Factory
 
 func NewTable(players []Playerish, shoe Shoeish) *Table {
-	return &Table{Players: players, Shoe: shoe}
+	return NewTableWithOptions(players, shoe, DefaultTableOptions())
+}
+
+func NewTableWithOptions(players []Playerish, shoe Shoeish, options TableOptions) *Table {
+	lo.ForEach(players, func(player Playerish, _ int) {
+		player.TakeSeat(options)
+	})
+
+	return &Table{Players: players, Shoe: shoe, Options: options}
+}
+
+func DefaultTableOptions() TableOptions {
+	return TableOptions{
+		SideBetPayTables: DefaultSideBetPayTables(),
+		BetLimitPolicy:   RefuseOutOfLimitBets,
+		DoubleAfterSplit: true,
+	}
 }
 
 // Public methods
 
 func (table *Table) Run() {
-	lo.ForEach(table.Players, func(player Playerish, index int) {
-		player.Bet()
-	})
+	trueCount := table.currentTrueCount()
+
+	spots := lo.Reduce(table.Players, func(acc int, player Playerish, _ int) int {
+		playerSpots, _ := player.Bet(trueCount)
+		player.PlaceSideBets(trueCount)
+		return lo.Max([]int{acc, playerSpots})
+	}, 1)
 
-	playerHand, dealerHand := table.dealHands()
+	playerHands, dealerHand := table.dealHands(spots)
 
-	table.playAllHands(playerHand, dealerHand)
+	table.playAllHands(playerHands, dealerHand)
 
 	dealerGame := table.playDealerHand(dealerHand)
 
@@ -44,30 +97,87 @@ func (table *Table) RunMany(games int) {
 		}
 
 		table.Run()
-		println("Game", i+1, "of", games)
 	}
 }
 
+func (options TableOptions) LimitBet(bet int) (int, BetLimitOutcome) {
+	// a bet can only be made of whole chips
+	limited := int(options.roundToChips(float64(bet)))
+
+	isBelowMinimum := limited < options.MinimumBet
+	isAboveMaximum := options.MaximumBet > 0 && limited > options.MaximumBet
+	if !isBelowMinimum && !isAboveMaximum {
+		return limited, BetAccepted
+	}
+
+	if options.BetLimitPolicy == RefuseOutOfLimitBets {
+		return 0, BetRefused
+	}
+
+	if isBelowMinimum {
+		return options.MinimumBet, BetClamped
+	}
+
+	return options.MaximumBet, BetClamped
+}
+
+// Winnings are paid in chips, so odd payouts such as 3:2 on a $5 bet are rounded down
+func (options TableOptions) RoundPayout(payout float64, bet int) float64 {
+	if payout <= float64(bet) {
+		return payout
+	}
+
+	return float64(bet) + options.roundToChips(payout-float64(bet))
+}
+
 // Private methods
 
-func (table *Table) dealHands() (playerHand Hand, dealerHand Hand) {
+func (options TableOptions) roundToChips(amount float64) float64 {
+	if len(options.ChipDenominations) == 0 {
+		return amount
+	}
+
+	smallestChip := lo.Min(options.ChipDenominations)
+	if smallestChip <= 0 {
+		return amount
+	}
+
+	return math.Floor(amount/smallestChip) * smallestChip
+}
+
+func (table *Table) currentTrueCount() int {
+	// the shoe is about to be reshuffled, so the count is about to reset
+	if table.Shoe.NeedsReshuffle() {
+		return 0
+	}
+
+	return table.Shoe.TrueCount()
+}
+
+func (table *Table) dealHands(spots int) (playerHands []Hand, dealerHand Hand) {
 	if table.Shoe.NeedsReshuffle() {
 		table.Shoe.Shuffle()
 	}
 
-	topCards := table.Shoe.Peek(4)
-	table.Shoe.AdvanceCursor(4)
+	// one card to each spot in seat order, then the dealer, twice
+	dealtCards := (spots + 1) * 2
+	topCards := table.Shoe.Peek(dealtCards)
+	table.Shoe.AdvanceCursor(dealtCards)
+
+	playerHands = make([]Hand, spots)
+	for spot := 0; spot < spots; spot++ {
+		playerHands[spot] = Hand{topCards[spot], topCards[spots+1+spot]}
+	}
 
-	playerHand = Hand{topCards[0], topCards[2]}
-	dealerHand = Hand{topCards[1], topCards[3]}
+	dealerHand = Hand{topCards[spots], topCards[dealtCards-1]}
 	dealerHand[1].SetHole()
 
-	return playerHand, dealerHand
+	return playerHands, dealerHand
 }
 
-func (table *Table) playAllHands(playerHand Hand, dealerHand Hand) {
+func (table *Table) playAllHands(playerHands []Hand, dealerHand Hand) {
 	index := lo.Reduce(table.Players, func(acc int, player Playerish, _ int) int {
-		usedCards := player.Play(playerHand, dealerHand, table.Shoe)
+		usedCards := player.Play(playerHands, dealerHand, table.Shoe)
 		return lo.Max([]int{acc, usedCards})
 	}, 0)
 
@@ -13,13 +13,19 @@ type playerSpy struct {
 	mock.Mock
 }
 
-func (p *playerSpy) Bet() (bool, int) {
-	args := p.Called()
-	return args.Bool(0), args.Int(1)
+func (p *playerSpy) TakeSeat(options TableOptions) {}
+
+func (p *playerSpy) PlaceSideBets(trueCount int) {
+	p.Called(trueCount)
+}
+
+func (p *playerSpy) Bet(trueCount int) (int, int) {
+	args := p.Called(trueCount)
+	return args.Int(0), args.Int(1)
 }
 
-func (p *playerSpy) Play(hand Hand, dealerHand Hand, shoe Shoeish) int {
-	args := p.Called(hand, dealerHand, shoe)
+func (p *playerSpy) Play(hands []Hand, dealerHand Hand, shoe Shoeish) int {
+	args := p.Called(hands, dealerHand, shoe)
 	return args.Int(0)
 }
 
@@ -51,6 +57,22 @@ func TestTableInitialization(t *testing.T) {
 		want := shoe
 		assert.Equal(t, want, got)
 	})
+
+	t.Run("Should initialize a table with the default options", func(t *testing.T) {
+		got := table.Options
+		want := DefaultTableOptions()
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("Should seat players with the given options", func(t *testing.T) {
+		options := TableOptions{SideBetPayTables: SideBetPayTables{}}
+		player := NewPlayer(makeMockStrategy(100.0))
+
+		table := NewTableWithOptions([]Playerish{player}, shoe, options)
+
+		assert.Equal(t, options, table.Options)
+		assert.Equal(t, options, player.tableOptions)
+	})
 }
 
 func TestDealingHands(t *testing.T) {
@@ -63,7 +85,7 @@ func TestDealingHands(t *testing.T) {
 	table := NewTable(players, shoe)
 
 	t.Run("Should advance the shoe cursor", func(t *testing.T) {
-		table.dealHands()
+		table.dealHands(1)
 
 		got := shoe.cursor
 		want := 4
@@ -73,17 +95,17 @@ func TestDealingHands(t *testing.T) {
 
 	t.Run("Should deal a player hand", func(t *testing.T) {
 		topCards := shoe.Peek(4)
-		playerHand, _ := table.dealHands()
+		playerHands, _ := table.dealHands(1)
 
-		got := playerHand
-		want := Hand{topCards[0], topCards[2]}
+		got := playerHands
+		want := []Hand{{topCards[0], topCards[2]}}
 
 		assert.Equal(t, want, got)
 	})
 
 	t.Run("Should deal a dealer hand", func(t *testing.T) {
 		topCards := shoe.Peek(4)
-		_, dealerHand := table.dealHands()
+		_, dealerHand := table.dealHands(1)
 
 		got := dealerHand
 		want := Hand{topCards[1], topCards[3]}
@@ -94,24 +116,41 @@ func TestDealingHands(t *testing.T) {
 
 	t.Run("Should shuffle the shoe if the penetration index is reached", func(t *testing.T) {
 		shoe.AdvanceCursor(27)
-		table.dealHands()
+		table.dealHands(1)
 
 		got := shoe.cursor
 		want := 4
 
 		assert.Equal(t, want, got)
 	})
+
+	t.Run("Should deal several spots in seat order", func(t *testing.T) {
+		shoe.Shuffle()
+		topCards := shoe.Peek(6)
+		playerHands, dealerHand := table.dealHands(2)
+
+		wantPlayerHands := []Hand{
+			{topCards[0], topCards[3]},
+			{topCards[1], topCards[4]},
+		}
+		wantDealerHand := Hand{topCards[2], topCards[5]}
+		wantDealerHand[1].SetHole()
+
+		assert.Equal(t, wantPlayerHands, playerHands)
+		assert.Equal(t, wantDealerHand, dealerHand)
+		assert.Equal(t, 6, shoe.cursor)
+	})
 }
 
 func TestPlayerGames(t *testing.T) {
 	spy1 := &playerSpy{}
-	spy1.On("Play", mock.AnythingOfType("Hand"), mock.AnythingOfType("Hand"), mock.Anything).Return(0)
+	spy1.On("Play", mock.AnythingOfType("[]blackjack.Hand"), mock.AnythingOfType("Hand"), mock.Anything).Return(0)
 
 	spy2 := &playerSpy{}
-	spy2.On("Play", mock.AnythingOfType("Hand"), mock.AnythingOfType("Hand"), mock.Anything).Return(5)
+	spy2.On("Play", mock.AnythingOfType("[]blackjack.Hand"), mock.AnythingOfType("Hand"), mock.Anything).Return(5)
 
 	spy3 := &playerSpy{}
-	spy3.On("Play", mock.AnythingOfType("Hand"), mock.AnythingOfType("Hand"), mock.Anything).Return(3)
+	spy3.On("Play", mock.AnythingOfType("[]blackjack.Hand"), mock.AnythingOfType("Hand"), mock.Anything).Return(3)
 
 	players := []Playerish{spy1, spy2, spy3}
 
@@ -120,10 +159,10 @@ func TestPlayerGames(t *testing.T) {
 
 	table := NewTable(players, shoe)
 
-	playerHand := Hand{}
+	playerHands := []Hand{{}}
 	dealerHand := Hand{}
 
-	table.playAllHands(playerHand, dealerHand)
+	table.playAllHands(playerHands, dealerHand)
 
 	t.Run("Should play each player's hand", func(t *testing.T) {
 		spy1.AssertNumberOfCalls(t, "Play", 1)
@@ -250,8 +289,9 @@ func TestTableRun(t *testing.T) {
 	asSpies := []*playerSpy{}
 	for i := 0; i < numberOfPlayers; i++ {
 		spy := &playerSpy{}
-		spy.On("Bet").Return(true, 10)
-		spy.On("Play", mock.AnythingOfType("Hand"), mock.AnythingOfType("Hand"), mock.Anything).Return(playerCards)
+		spy.On("Bet", mock.AnythingOfType("int")).Return(1, 10)
+		spy.On("PlaceSideBets", mock.AnythingOfType("int")).Return()
+		spy.On("Play", mock.AnythingOfType("[]blackjack.Hand"), mock.AnythingOfType("Hand"), mock.Anything).Return(playerCards)
 		spy.On("Resolve", mock.AnythingOfType("Hand")).Return()
 
 		asPlayers = append(asPlayers, spy)
@@ -276,12 +316,20 @@ func TestTableRun(t *testing.T) {
 	table := NewTable(asPlayers, shoe)
 	table.Run()
 
-	t.Run("Should call Bet() on each player once", func(t *testing.T) {
+	t.Run("Should call Bet() on each player once, with the true count", func(t *testing.T) {
 		for _, spy := range asSpies {
+			spy.AssertCalled(t, "Bet", 0)
 			spy.AssertNumberOfCalls(t, "Bet", 1)
 		}
 	})
 
+	t.Run("Should call PlaceSideBets() on each player once, with the true count", func(t *testing.T) {
+		for _, spy := range asSpies {
+			spy.AssertCalled(t, "PlaceSideBets", 0)
+			spy.AssertNumberOfCalls(t, "PlaceSideBets", 1)
+		}
+	})
+
 	t.Run("Should call Play() on each player once", func(t *testing.T) {
 		for _, spy := range asSpies {
 			spy.AssertNumberOfCalls(t, "Play", 1)
@@ -302,6 +350,38 @@ func TestTableRun(t *testing.T) {
 	})
 }
 
+func TestTableRunWithSpots(t *testing.T) {
+	oneSpot := &playerSpy{}
+	oneSpot.On("Bet", mock.AnythingOfType("int")).Return(1, 10)
+	oneSpot.On("PlaceSideBets", mock.AnythingOfType("int")).Return()
+	oneSpot.On("Play", mock.AnythingOfType("[]blackjack.Hand"), mock.AnythingOfType("Hand"), mock.Anything).Return(0)
+	oneSpot.On("Resolve", mock.AnythingOfType("Hand")).Return()
+
+	threeSpots := &playerSpy{}
+	threeSpots.On("Bet", mock.AnythingOfType("int")).Return(3, 10)
+	threeSpots.On("PlaceSideBets", mock.AnythingOfType("int")).Return()
+	threeSpots.On("Play", mock.AnythingOfType("[]blackjack.Hand"), mock.AnythingOfType("Hand"), mock.Anything).Return(0)
+	threeSpots.On("Resolve", mock.AnythingOfType("Hand")).Return()
+
+	shoe := NewShoe(1)
+	table := NewTable([]Playerish{oneSpot, threeSpots}, shoe)
+	table.Run()
+
+	t.Run("Should deal as many hands as the most spots played", func(t *testing.T) {
+		hands := threeSpots.Calls[2].Arguments.Get(0).([]Hand)
+		assert.Len(t, hands, 3)
+	})
+
+	t.Run("Should give every player the same hands", func(t *testing.T) {
+		assert.Equal(t, oneSpot.Calls[2].Arguments.Get(0), threeSpots.Calls[2].Arguments.Get(0))
+	})
+
+	t.Run("Should advance the shoe cursor by the dealt cards", func(t *testing.T) {
+		// 3 spots + dealer, 2 cards each, and the dealer draws to 17 or more
+		assert.GreaterOrEqual(t, shoe.cursor, 8)
+	})
+}
+
 func TestTableRunMany(t *testing.T) {
 	shoe := NewShoe(1)
 	shoe.SetPenetration(0.5)
@@ -351,3 +431,132 @@ func TestTableRunMany(t *testing.T) {
 		assert.Equal(t, want, got)
 	})
 }
+
+func TestTableOptionsBetLimits(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		options     TableOptions
+		bet         int
+		wantBet     int
+		wantOutcome BetLimitOutcome
+	}{
+		{
+			desc:        "Should accept any bet without limits",
+			options:     TableOptions{},
+			bet:         1234,
+			wantBet:     1234,
+			wantOutcome: BetAccepted,
+		},
+		{
+			desc:        "Should accept bets within the limits",
+			options:     TableOptions{MinimumBet: 5, MaximumBet: 500},
+			bet:         25,
+			wantBet:     25,
+			wantOutcome: BetAccepted,
+		},
+		{
+			desc:        "Should refuse bets under the minimum",
+			options:     TableOptions{MinimumBet: 5, MaximumBet: 500, BetLimitPolicy: RefuseOutOfLimitBets},
+			bet:         4,
+			wantBet:     0,
+			wantOutcome: BetRefused,
+		},
+		{
+			desc:        "Should refuse bets over the maximum",
+			options:     TableOptions{MinimumBet: 5, MaximumBet: 500, BetLimitPolicy: RefuseOutOfLimitBets},
+			bet:         501,
+			wantBet:     0,
+			wantOutcome: BetRefused,
+		},
+		{
+			desc:        "Should clamp bets under the minimum",
+			options:     TableOptions{MinimumBet: 5, MaximumBet: 500, BetLimitPolicy: ClampOutOfLimitBets},
+			bet:         4,
+			wantBet:     5,
+			wantOutcome: BetClamped,
+		},
+		{
+			desc:        "Should clamp bets over the maximum",
+			options:     TableOptions{MinimumBet: 5, MaximumBet: 500, BetLimitPolicy: ClampOutOfLimitBets},
+			bet:         501,
+			wantBet:     500,
+			wantOutcome: BetClamped,
+		},
+		{
+			desc:        "Should round bets down to the smallest chip",
+			options:     TableOptions{ChipDenominations: []float64{25, 5, 100}},
+			bet:         27,
+			wantBet:     25,
+			wantOutcome: BetAccepted,
+		},
+		{
+			desc:        "Should apply the limits to the rounded bet",
+			options:     TableOptions{MinimumBet: 5, ChipDenominations: []float64{5}},
+			bet:         4,
+			wantBet:     0,
+			wantOutcome: BetRefused,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.desc, func(t *testing.T) {
+			gotBet, gotOutcome := testCase.options.LimitBet(testCase.bet)
+
+			assert.Equal(t, testCase.wantBet, gotBet)
+			assert.Equal(t, testCase.wantOutcome, gotOutcome)
+		})
+	}
+}
+
+func TestTableOptionsPayoutRounding(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		options TableOptions
+		payout  float64
+		bet     int
+		want    float64
+	}{
+		{
+			desc:    "Should not round without chips",
+			options: TableOptions{},
+			payout:  12.5,
+			bet:     5,
+			want:    12.5,
+		},
+		{
+			desc:    "Should round 3:2 on odd bets down to the smallest chip",
+			options: TableOptions{ChipDenominations: []float64{1, 5, 25}},
+			payout:  12.5,
+			bet:     5,
+			want:    12.0,
+		},
+		{
+			desc:    "Should pay 3:2 exactly when there are half chips",
+			options: TableOptions{ChipDenominations: []float64{0.5, 1, 5}},
+			payout:  12.5,
+			bet:     5,
+			want:    12.5,
+		},
+		{
+			desc:    "Should never round the returned bet",
+			options: TableOptions{ChipDenominations: []float64{5}},
+			payout:  3,
+			bet:     3,
+			want:    3,
+		},
+		{
+			desc:    "Should not round losses",
+			options: TableOptions{ChipDenominations: []float64{5}},
+			payout:  0,
+			bet:     3,
+			want:    0,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.desc, func(t *testing.T) {
+			got := testCase.options.RoundPayout(testCase.payout, testCase.bet)
+			assert.Equal(t, testCase.want, got)
+		})
+	}
+}
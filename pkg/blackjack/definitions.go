@@ -1,5 +1,11 @@
 package blackjack
 
+import (
+	"fmt"
+
+	"github.com/samber/lo"
+)
+
 type DealerHand int
 
 const (
@@ -73,6 +79,28 @@ const PlayerPairHandCount = 10
 const PlayerHandCount = PlayerHardHandCount + PlayerSoftHandCount + PlayerPairHandCount
 const HandCount = PlayerHandCount * DealerHandCount
 
+// Composition dependent overrides apply to hard 12 through 16
+const CompositionHardHandCount = 5
+
+// Card count buckets for composition dependent overrides: 3 cards, 4 or more cards
+const CardCountBucketCount = 2
+
+// Two card hard totals, by card value, that can be played differently from their total
+type TwoCardCombo struct {
+	High int
+	Low  int
+}
+
+var TWO_CARD_COMBOS = [...]TwoCardCombo{
+	{10, 2}, {9, 3}, {8, 4}, {7, 5},
+	{10, 3}, {9, 4}, {8, 5}, {7, 6},
+	{10, 4}, {9, 5}, {8, 6},
+	{10, 5}, {9, 6}, {8, 7},
+	{10, 6}, {9, 7},
+}
+
+const TwoCardComboCount = len(TWO_CARD_COMBOS)
+
 type PlayerAction string
 
 const (
@@ -81,7 +109,140 @@ const (
 	Double       PlayerAction = "D"
 	SplitOrHit   PlayerAction = "P"
 	SplitOrStand PlayerAction = "T"
+	// Not played yet, but charts from elsewhere use it
+	Surrender PlayerAction = "R"
+	// Composition dependent cells only: defer to the cell for the hand total
+	NoOverride PlayerAction = "-"
 )
 
+// True count buckets: <= 0, 1, 2, 3, >= 4
+const CountBucketCount = 5
+
 const bankrollLength = 4
 const mainBetLength = 4
+const spotsLength = CountBucketCount
+
+// Spots a strategy plays at once, from 1 to MaxSpots
+const MaxSpots = 3
+const cardCountLength = CardCountBucketCount * CompositionHardHandCount * DealerHandCount
+const twoCardComboLength = TwoCardComboCount * DealerHandCount
+
+// A named block of cells in the raw strategy, laid out as a grid of Rows x Columns
+type StrategySection struct {
+	Name string
+	// Identifies the section in the strategy header, must never change
+	Code         byte
+	Description  string
+	Rows         int
+	Columns      int
+	RowLabels    []string
+	ColumnLabels []string
+	Alphabet     []byte
+	// Fills the section when a strategy file leaves it out, 0 if it is required
+	Default byte
+}
+
+var DEALER_LABELS = []string{"2", "3", "4", "5", "6", "7", "8", "9", "10", "A"}
+var HARD_LABELS = []string{"5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20"}
+var SOFT_LABELS = []string{"13", "14", "15", "16", "17", "18", "19", "20"}
+var PAIR_LABELS = []string{"2-2", "3-3", "4-4", "5-5", "6-6", "7-7", "8-8", "9-9", "10-10", "A-A"}
+var CARD_COUNT_LABELS = []string{
+	"12 (3 cards)", "13 (3 cards)", "14 (3 cards)", "15 (3 cards)", "16 (3 cards)",
+	"12 (4+ cards)", "13 (4+ cards)", "14 (4+ cards)", "15 (4+ cards)", "16 (4+ cards)",
+}
+var TWO_CARD_COMBO_LABELS = lo.Map(TWO_CARD_COMBOS[:], func(combo TwoCardCombo, _ int) string {
+	return fmt.Sprintf("%d-%d", combo.High, combo.Low)
+})
+var COUNT_BUCKET_LABELS = []string{"TC <= 0", "TC 1", "TC 2", "TC 3", "TC >= 4"}
+
+var hitStandDouble = []byte("HSD")
+var hexDigits = []byte("0123456789ABCDEF")
+
+// In the order they appear in the raw strategy
+var STRATEGY_SECTIONS = [...]StrategySection{
+	{
+		Name: "hard", Code: 'h', Description: "Hard totals 5 to 20, by dealer upcard 2 to A",
+		Rows: PlayerHardHandCount, Columns: DealerHandCount, RowLabels: HARD_LABELS, ColumnLabels: DEALER_LABELS,
+		Alphabet: hitStandDouble,
+	},
+	{
+		Name: "soft", Code: 's', Description: "Soft totals 13 to 20, by dealer upcard 2 to A",
+		Rows: PlayerSoftHandCount, Columns: DealerHandCount, RowLabels: SOFT_LABELS, ColumnLabels: DEALER_LABELS,
+		Alphabet: hitStandDouble,
+	},
+	{
+		Name: "pair", Code: 'p', Description: "Pairs 2-2 to 10-10 then A-A, by dealer upcard 2 to A",
+		Rows: PlayerPairHandCount, Columns: DealerHandCount, RowLabels: PAIR_LABELS, ColumnLabels: DEALER_LABELS,
+		Alphabet: []byte("HSDP"),
+	},
+	{
+		Name: "bankroll", Code: 'b', Description: "Initial bankroll, hex",
+		Rows: 1, Columns: bankrollLength,
+		Alphabet: hexDigits,
+	},
+	{
+		Name: "bet", Code: 'm', Description: "Main bet, hex",
+		Rows: 1, Columns: mainBetLength,
+		Alphabet: hexDigits,
+	},
+	{
+		Name: "spots", Code: 'n', Description: "Spots played by true count: <= 0, 1, 2, 3, >= 4",
+		Rows: 1, Columns: spotsLength, ColumnLabels: COUNT_BUCKET_LABELS,
+		Alphabet: []byte("123"), Default: '1',
+	},
+	{
+		Name: "card-count", Code: 'c', Description: "Hard 12 to 16 with 3 cards, then with 4 or more cards",
+		Rows: CardCountBucketCount * CompositionHardHandCount, Columns: DealerHandCount, RowLabels: CARD_COUNT_LABELS, ColumnLabels: DEALER_LABELS,
+		Alphabet: []byte("-HS"), Default: '-',
+	},
+	{
+		Name: "two-card-combo", Code: 'w', Description: "Two card hard 12 to 16, by card values",
+		Rows: TwoCardComboCount, Columns: DealerHandCount, RowLabels: TWO_CARD_COMBO_LABELS, ColumnLabels: DEALER_LABELS,
+		Alphabet: []byte("-HSD"), Default: '-',
+	},
+	{
+		Name: "split-hard", Code: 'H', Description: "Hard totals 5 to 20 after a split",
+		Rows: PlayerHardHandCount, Columns: DealerHandCount, RowLabels: HARD_LABELS, ColumnLabels: DEALER_LABELS,
+		Alphabet: []byte("-HSD"), Default: '-',
+	},
+	{
+		Name: "split-soft", Code: 'S', Description: "Soft totals 13 to 20 after a split",
+		Rows: PlayerSoftHandCount, Columns: DealerHandCount, RowLabels: SOFT_LABELS, ColumnLabels: DEALER_LABELS,
+		Alphabet: []byte("-HSD"), Default: '-',
+	},
+	{
+		Name: "split-pair", Code: 'P', Description: "Pairs 2-2 to 10-10 then A-A after a split",
+		Rows: PlayerPairHandCount, Columns: DealerHandCount, RowLabels: PAIR_LABELS, ColumnLabels: DEALER_LABELS,
+		Alphabet: []byte("-HSDP"), Default: '-',
+	},
+	{
+		// the levels of genetics.RateGeneBases, see RateGene
+		Name: "mutation-rate", Code: 'r', Description: "Self-adaptive mutation rate level, - when none is carried",
+		Rows: 1, Columns: 1,
+		Alphabet: []byte("-0123456789ABCDEF"), Default: '-',
+	},
+}
+
+func (section StrategySection) Length() int {
+	return section.Rows * section.Columns
+}
+
+// Describes a cell of the section, as in "hard 12 vs 4" or "bankroll digit 2"
+func (section StrategySection) CellLabel(index int) string {
+	row, column := index/section.Columns, index%section.Columns
+
+	label := section.Name
+	if section.RowLabels != nil {
+		label += " " + section.RowLabels[row]
+	}
+
+	if section.ColumnLabels == nil {
+		return fmt.Sprintf("%s digit %d", label, column+1)
+	}
+
+	if section.RowLabels == nil {
+		return label + " " + section.ColumnLabels[column]
+	}
+
+	return label + " vs " + section.ColumnLabels[column]
+}
@@ -0,0 +1,109 @@
+package genetics
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+// A candidate placed by its behaviour, every descriptor from 0 to 1
+type ArchiveEntry struct {
+	Chromosome *Chromosome
+	Fitness    float64
+	Descriptor []float64
+}
+
+// A MAP-Elites grid over the behaviour descriptors, each cell holding the fittest candidate that behaved like it
+type Archive struct {
+	// Cells along each descriptor
+	Bins  []int
+	cells map[string]*ArchiveEntry
+}
+
+// Factory
+
+func NewArchive(bins []int) *Archive {
+	return &Archive{bins, map[string]*ArchiveEntry{}}
+}
+
+// Public methods
+
+// The cell of each descriptor, descriptors outside 0 to 1 falling in the cells at either end
+func (archive *Archive) Cell(descriptor []float64) []int {
+	return lo.Map(archive.Bins, func(bins int, i int) int {
+		if math.IsNaN(descriptor[i]) {
+			return 0
+		}
+
+		return lo.Clamp(int(descriptor[i]*float64(bins)), 0, bins-1)
+	})
+}
+
+// Keeps the entry when its cell is empty or holds a less fit one
+func (archive *Archive) Add(entry *ArchiveEntry) bool {
+	key := cellKey(archive.Cell(entry.Descriptor))
+
+	current, found := archive.cells[key]
+	if found && current.Fitness >= entry.Fitness {
+		return false
+	}
+
+	archive.cells[key] = entry
+	return true
+}
+
+// The entries of every filled cell, in cell order
+func (archive *Archive) Elites() []*ArchiveEntry {
+	keys := lo.Keys(archive.cells)
+	sort.Slice(keys, func(i, j int) bool {
+		return lessCell(archive.Cell(archive.cells[keys[i]].Descriptor), archive.Cell(archive.cells[keys[j]].Descriptor))
+	})
+
+	return lo.Map(keys, func(key string, _ int) *ArchiveEntry { return archive.cells[key] })
+}
+
+// Share of the cells holding a candidate
+func (archive *Archive) Coverage() float64 {
+	total := 1
+	for _, bins := range archive.Bins {
+		total *= bins
+	}
+
+	return float64(len(archive.cells)) / float64(total)
+}
+
+// Offspring of elites picked at random from the archive, random candidates while it is empty.
+// The elites stay in the archive, so none are carried over here.
+func NewMapElitesGeneration(archive *Archive, sequencing [][]byte, options GenerationOptions, randomizer Randomizerish) []*Candidate {
+	// every cell has the same chance to breed, whatever its fitness
+	parents := lo.Map(archive.Elites(), func(entry *ArchiveEntry, _ int) *Candidate {
+		return &Candidate{entry.Chromosome, 1.0}
+	})
+
+	options.Selector = TournamentSelector{Size: 1}
+	options.CloneRate = 0
+	options.Elitism = 0
+	options.Scaling = MaxScaling{}
+	options.SharingRadius = 0
+
+	return NewGenerationFromPrevious(parents, sequencing, options, randomizer)
+}
+
+// Helper methods
+
+func cellKey(cell []int) string {
+	return strings.Join(lo.Map(cell, func(index int, _ int) string { return fmt.Sprint(index) }), ",")
+}
+
+func lessCell(a []int, b []int) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return a[i] < b[i]
+		}
+	}
+
+	return false
+}
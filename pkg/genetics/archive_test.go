@@ -0,0 +1,98 @@
+package genetics
+
+import (
+	"math"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Helpers
+
+func archiveEntry(fitness float64, descriptor ...float64) *ArchiveEntry {
+	return &ArchiveEntry{Fitness: fitness, Descriptor: descriptor}
+}
+
+// Tests
+
+func TestArchive(t *testing.T) {
+	t.Run("Should place descriptors in cells", func(t *testing.T) {
+		archive := NewArchive([]int{4, 2})
+
+		assert.Equal(t, []int{1, 1}, archive.Cell([]float64{0.3, 0.5}))
+		assert.Equal(t, []int{3, 0}, archive.Cell([]float64{1, 0}))
+		assert.Equal(t, []int{0, 1}, archive.Cell([]float64{-0.5, 7}), "outside 0 to 1 at either end")
+		assert.Equal(t, []int{0, 0}, archive.Cell([]float64{math.NaN(), 0}))
+	})
+
+	t.Run("Should keep the fittest candidate of each cell", func(t *testing.T) {
+		archive := NewArchive([]int{2})
+		weak := archiveEntry(1, 0.1)
+		strong := archiveEntry(5, 0.2)
+
+		assert.True(t, archive.Add(weak))
+		assert.True(t, archive.Add(strong))
+		assert.False(t, archive.Add(archiveEntry(3, 0.3)))
+		assert.False(t, archive.Add(archiveEntry(5, 0.4)), "ties stay with the one already there")
+
+		assert.Equal(t, []*ArchiveEntry{strong}, archive.Elites())
+	})
+
+	t.Run("Should list elites in cell order", func(t *testing.T) {
+		archive := NewArchive([]int{2, 2})
+		last := archiveEntry(1, 0.9, 0.9)
+		first := archiveEntry(1, 0.1, 0.1)
+		middle := archiveEntry(1, 0.1, 0.9)
+
+		archive.Add(last)
+		archive.Add(first)
+		archive.Add(middle)
+
+		assert.Equal(t, []*ArchiveEntry{first, middle, last}, archive.Elites())
+	})
+
+	t.Run("Should measure the share of cells filled", func(t *testing.T) {
+		archive := NewArchive([]int{2, 5})
+		assert.Equal(t, 0.0, archive.Coverage())
+
+		archive.Add(archiveEntry(1, 0, 0))
+		archive.Add(archiveEntry(1, 0.9, 0.9))
+
+		assert.Equal(t, 0.2, archive.Coverage())
+	})
+}
+
+func TestNewMapElitesGeneration(t *testing.T) {
+	bases := []byte("ABC")
+	sequencing := [][]byte{bases, bases, bases}
+	options := GenerationOptions{PopulationSize: 2, MutationRate: 0.1, CloneRate: 0.5, Elitism: 2}
+
+	t.Run("Should start from random candidates", func(t *testing.T) {
+		randomizerMock := &RandomizerMock{}
+		randomizerMock.On("PickOne", bases).Return(bases[2])
+
+		got := NewMapElitesGeneration(NewArchive([]int{2}), sequencing, options, randomizerMock)
+
+		assert.Len(t, got, 2)
+		assert.Equal(t, []byte("CCC"), got[0].Chromosome.Raw())
+	})
+
+	t.Run("Should breed elites picked at random, without carrying them over", func(t *testing.T) {
+		archive := NewArchive([]int{2})
+		elite := &ArchiveEntry{NewChromosome([]byte("BBB"), sequencing), 10, []float64{0.7}}
+		archive.Add(elite)
+
+		randomizerMock := &RandomizerMock{}
+		randomizerMock.On("NumberBetween", 0, 1).Return(0)
+		randomizerMock.On("EventDidHappen", mock.Anything).Return(false)
+
+		got := NewMapElitesGeneration(archive, sequencing, options, randomizerMock)
+
+		assert.Len(t, got, 2)
+		assert.True(t, lo.EveryBy(got, func(candidate *Candidate) bool {
+			return string(candidate.Chromosome.Raw()) == "BBB" && candidate.Chromosome != elite.Chromosome
+		}))
+	})
+}
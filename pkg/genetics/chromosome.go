@@ -1,12 +1,20 @@
 package genetics
 
+import (
+	"github.com/samber/lo"
+)
+
 type Chromosome struct {
 	raw        []byte
 	sequencing [][]byte
+	frozen     FreezeMask
+	// The chromosome carries its own mutation rate in its rate gene, see RateGeneBases
+	selfAdaptive bool
+	rateGene     int
 }
 
 func NewChromosome(raw []byte, sequencing [][]byte) *Chromosome {
-	return &Chromosome{raw, sequencing}
+	return &Chromosome{raw, sequencing, nil, false, 0}
 }
 
 func NewRandomChromosome(sequencing [][]byte, randomizer Randomizerish) *Chromosome {
@@ -22,19 +30,57 @@ func (chromosome *Chromosome) Raw() []byte {
 	return chromosome.raw
 }
 
+// The rate a self-adaptive chromosome carries in its rate gene, 0 when it carries none
+func (chromosome *Chromosome) MutationRate() float64 {
+	if !chromosome.selfAdaptive {
+		return 0
+	}
+
+	return rateOfGene(chromosome.raw[chromosome.rateGene])
+}
+
+// Makes the chromosome carry its own mutation rate in a gene, passed on to its offspring through crossover only
+func (chromosome *Chromosome) WithMutationRate(rateGene int, rate float64) *Chromosome {
+	chromosome.selfAdaptive = true
+	chromosome.rateGene = rateGene
+	chromosome.raw[rateGene] = geneOfRate(rate)
+	return chromosome
+}
+
+// Makes the chromosome read its mutation rate from a gene, as it was saved; the given rate when it carries none
+func (chromosome *Chromosome) SelfAdaptive(rateGene int, rate float64) *Chromosome {
+	chromosome.selfAdaptive = true
+	chromosome.rateGene = rateGene
+	if chromosome.MutationRate() == 0 {
+		chromosome.WithMutationRate(rateGene, rate)
+	}
+
+	return chromosome
+}
+
 func (chromosome *Chromosome) Merge(other *Chromosome, mutationRate float64, randomizer Randomizerish) *Chromosome {
-	merged := make([]byte, len(chromosome.raw))
-	myGeneWasChosen := func() bool { return randomizer.EventDidHappen(0.5) }
+	return chromosome.MergeWith(other, UniformCrossover{}, mutationRate, randomizer)
+}
 
-	for i := 0; i < len(chromosome.raw); i++ {
-		if myGeneWasChosen() {
-			merged[i] = chromosome.raw[i]
-		} else {
-			merged[i] = other.raw[i]
+// Merges with a crossover operator, the fitter parent being the receiver
+func (chromosome *Chromosome) MergeWith(other *Chromosome, crossover Crossoverish, mutationRate float64, randomizer Randomizerish) *Chromosome {
+	merged := crossover.Cross(chromosome.raw, other.raw, randomizer)
+	child := NewChromosome(merged, chromosome.sequencing).Freeze(chromosome.frozen)
+
+	// a self-adaptive child inherits the rate gene of either parent through crossover, nudges it a level, then mutates with it
+	if chromosome.selfAdaptive {
+		rate := lo.Ternary(chromosome.MutationRate() > 0, chromosome.MutationRate(), mutationRate)
+		child.SelfAdaptive(chromosome.rateGene, rate)
+
+		step := selfAdaptiveRateStep
+		if !randomizer.EventDidHappen(0.5) {
+			step = 1 / selfAdaptiveRateStep
 		}
+		child.WithMutationRate(chromosome.rateGene, child.MutationRate()*step)
+		mutationRate = child.MutationRate()
 	}
 
-	return NewChromosome(merged, chromosome.sequencing).Mutate(mutationRate, randomizer)
+	return child.Mutate(mutationRate, randomizer)
 }
 
 func (chromosome *Chromosome) Mutate(mutationRate float64, randomizer Randomizerish) *Chromosome {
@@ -42,7 +88,9 @@ func (chromosome *Chromosome) Mutate(mutationRate float64, randomizer Randomizer
 	shouldMutate := func() bool { return randomizer.EventDidHappen(mutationRate) }
 
 	for i := 0; i < len(chromosome.raw); i++ {
-		if shouldMutate() {
+		if chromosome.isHeld(i) {
+			mutated[i] = chromosome.raw[i]
+		} else if shouldMutate() {
 			mutated[i] = randomizer.PickOne(chromosome.sequencing[i])
 		} else {
 			mutated[i] = chromosome.raw[i]
@@ -52,3 +100,46 @@ func (chromosome *Chromosome) Mutate(mutationRate float64, randomizer Randomizer
 	chromosome.raw = mutated
 	return chromosome
 }
+
+// Replaces every gene the constraint rules out with one of the bases it allows
+func (chromosome *Chromosome) Repair(constraint Constraint, randomizer Randomizerish) *Chromosome {
+	if constraint == nil {
+		return chromosome
+	}
+
+	for i := 0; i < len(chromosome.raw); i++ {
+		if constraint(i, chromosome.raw[i]) {
+			continue
+		}
+
+		allowed := AllowedBases(chromosome.sequencing[i], i, constraint)
+		if len(allowed) > 0 {
+			chromosome.raw[i] = randomizer.PickOne(allowed)
+		}
+	}
+
+	return chromosome
+}
+
+// Holds the frozen genes at the values of the mask, from now on and in every offspring
+func (chromosome *Chromosome) Freeze(mask FreezeMask) *Chromosome {
+	if mask == nil {
+		return chromosome
+	}
+
+	chromosome.frozen = mask
+	for i := 0; i < len(chromosome.raw); i++ {
+		if mask.IsFrozen(i) {
+			chromosome.raw[i] = mask[i]
+		}
+	}
+
+	return chromosome
+}
+
+// Helper methods
+
+// Whether mutation must leave a gene alone: frozen, or the rate gene, which only moves a level at a time
+func (chromosome *Chromosome) isHeld(gene int) bool {
+	return chromosome.frozen.IsFrozen(gene) || (chromosome.selfAdaptive && gene == chromosome.rateGene)
+}
@@ -66,3 +66,86 @@ func TestChromosomeMerging(t *testing.T) {
 		assert.Equal(t, want, got.Raw())
 	})
 }
+
+func TestChromosomeRepair(t *testing.T) {
+	bases := []byte("ABC")
+	sequencing := [][]byte{bases, bases, bases, bases, bases}
+	// no B past the second gene
+	constraint := func(gene int, base byte) bool { return gene < 2 || base != 'B' }
+
+	t.Run("Should replace the genes the constraint rules out", func(t *testing.T) {
+		randomizerMock := &RandomizerMock{}
+		randomizerMock.On("PickOne", []byte("AC")).Return(byte('C'))
+
+		want := []byte("BBCAC")
+		got := NewChromosome([]byte("BBBAB"), sequencing).Repair(constraint, randomizerMock)
+
+		assert.Equal(t, want, got.Raw())
+		randomizerMock.AssertNumberOfCalls(t, "PickOne", 2)
+	})
+
+	t.Run("Should leave the genome alone without a constraint", func(t *testing.T) {
+		randomizerMock := &RandomizerMock{}
+
+		want := []byte("BBBAB")
+		got := NewChromosome([]byte("BBBAB"), sequencing).Repair(nil, randomizerMock)
+
+		assert.Equal(t, want, got.Raw())
+		randomizerMock.AssertNotCalled(t, "PickOne", bases)
+	})
+
+	t.Run("Should keep a gene no base of which is allowed", func(t *testing.T) {
+		randomizerMock := &RandomizerMock{}
+		nothing := func(gene int, base byte) bool { return false }
+
+		want := []byte("AAAAA")
+		got := NewChromosome([]byte("AAAAA"), sequencing).Repair(nothing, randomizerMock)
+
+		assert.Equal(t, want, got.Raw())
+	})
+}
+
+func TestChromosomeFreezing(t *testing.T) {
+	bases := []byte("ABC")
+	sequencing := [][]byte{bases, bases, bases, bases, bases}
+	mutationRate := 0.1
+	// hold the first two genes at C
+	mask := FreezeMask{'C', 'C', Unfrozen, Unfrozen, Unfrozen}
+
+	t.Run("Should hold frozen genes at the values of the mask", func(t *testing.T) {
+		want := []byte("CCAAA")
+		got := NewChromosome([]byte("AAAAA"), sequencing).Freeze(mask)
+
+		assert.Equal(t, want, got.Raw())
+	})
+
+	t.Run("Should never mutate frozen genes", func(t *testing.T) {
+		randomizerMock := &RandomizerMock{}
+		randomizerMock.On("EventDidHappen", mutationRate).Return(true)
+		randomizerMock.On("PickOne", bases).Return(byte('B'))
+
+		want := []byte("CCBBB")
+		got := NewChromosome([]byte("AAAAA"), sequencing).Freeze(mask).Mutate(mutationRate, randomizerMock)
+
+		assert.Equal(t, want, got.Raw())
+		randomizerMock.AssertNumberOfCalls(t, "EventDidHappen", 3)
+	})
+
+	t.Run("Should pass frozen genes on to the offspring", func(t *testing.T) {
+		randomizerMock := &RandomizerMock{}
+		// pick all the other genes, then mutate them all
+		randomizerMock.On("EventDidHappen", 0.5).Return(false)
+		randomizerMock.On("EventDidHappen", mutationRate).Return(true)
+		randomizerMock.On("PickOne", bases).Return(byte('A'))
+
+		subjectA := NewChromosome([]byte("AAAAA"), sequencing).Freeze(mask)
+		subjectB := NewChromosome([]byte("BBBBB"), sequencing)
+
+		want := []byte("CCAAA")
+		got := subjectA.Merge(subjectB, mutationRate, randomizerMock)
+
+		assert.Equal(t, want, got.Raw())
+		// frozen genes are crossed over too, but never mutated
+		randomizerMock.AssertNumberOfCalls(t, "EventDidHappen", 8)
+	})
+}
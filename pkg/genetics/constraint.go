@@ -0,0 +1,20 @@
+package genetics
+
+// Whether a gene may hold a base; bases it rules out are never explored
+type Constraint func(gene int, base byte) bool
+
+// The bases of a gene the constraint allows, all of them without a constraint
+func AllowedBases(bases []byte, gene int, constraint Constraint) []byte {
+	if constraint == nil {
+		return bases
+	}
+
+	allowed := []byte{}
+	for _, base := range bases {
+		if constraint(gene, base) {
+			allowed = append(allowed, base)
+		}
+	}
+
+	return allowed
+}
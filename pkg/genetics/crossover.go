@@ -0,0 +1,91 @@
+package genetics
+
+import (
+	"sort"
+
+	"github.com/samber/lo"
+)
+
+type Crossoverish interface {
+	// The genome of a child, the first parent being the fitter one
+	Cross(mother []byte, father []byte, randomizer Randomizerish) []byte
+}
+
+// Every gene from either parent, from the first one with probability Bias; 0.5 when unset
+type UniformCrossover struct {
+	Bias float64
+}
+
+// The genes before a random cut from the first parent, the rest from the second
+type OnePointCrossover struct{}
+
+// The genes between two random cuts from the second parent, the rest from the first
+type TwoPointCrossover struct{}
+
+// Each block whole from either parent, genes outside every block from the first one
+type BlockCrossover struct {
+	Blocks []Block
+}
+
+// A run of genes kept whole; rows of the same group whose totals are one apart are neighbours
+type Block struct {
+	Group  string
+	Start  int
+	Length int
+	// Optional, the total a row stands for; rows without one have no neighbours
+	Total int
+}
+
+// Public methods
+
+func (crossover UniformCrossover) Cross(mother []byte, father []byte, randomizer Randomizerish) []byte {
+	bias := lo.Ternary(crossover.Bias > 0, crossover.Bias, 0.5)
+	child := make([]byte, len(mother))
+
+	for i := 0; i < len(mother); i++ {
+		if randomizer.EventDidHappen(bias) {
+			child[i] = mother[i]
+		} else {
+			child[i] = father[i]
+		}
+	}
+
+	return child
+}
+
+func (crossover OnePointCrossover) Cross(mother []byte, father []byte, randomizer Randomizerish) []byte {
+	child := append([]byte{}, mother...)
+	if len(mother) < 2 {
+		return child
+	}
+
+	cut := randomizer.NumberBetween(1, len(mother))
+	copy(child[cut:], father[cut:])
+
+	return child
+}
+
+func (crossover TwoPointCrossover) Cross(mother []byte, father []byte, randomizer Randomizerish) []byte {
+	child := append([]byte{}, mother...)
+	if len(mother) < 2 {
+		return child
+	}
+
+	cuts := []int{randomizer.NumberBetween(1, len(mother)), randomizer.NumberBetween(1, len(mother))}
+	sort.Ints(cuts)
+	copy(child[cuts[0]:cuts[1]], father[cuts[0]:cuts[1]])
+
+	return child
+}
+
+func (crossover BlockCrossover) Cross(mother []byte, father []byte, randomizer Randomizerish) []byte {
+	child := append([]byte{}, mother...)
+
+	for _, block := range crossover.Blocks {
+		if !randomizer.EventDidHappen(0.5) {
+			copy(child[block.Start:block.Start+block.Length], father[block.Start:])
+		}
+	}
+
+	return child
+}
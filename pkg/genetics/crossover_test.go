@@ -0,0 +1,80 @@
+package genetics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUniformCrossover(t *testing.T) {
+	mother := []byte("AAAA")
+	father := []byte("BBBB")
+
+	t.Run("Should take each gene from the first parent with the bias", func(t *testing.T) {
+		randomizerMock := &RandomizerMock{}
+		randomizerMock.On("EventDidHappen", 0.8).Return(true).Times(3)
+		randomizerMock.On("EventDidHappen", 0.8).Return(false).Once()
+
+		got := UniformCrossover{Bias: 0.8}.Cross(mother, father, randomizerMock)
+
+		assert.Equal(t, []byte("AAAB"), got)
+		randomizerMock.AssertExpectations(t)
+	})
+
+	t.Run("Should be fair by default", func(t *testing.T) {
+		randomizerMock := &RandomizerMock{}
+		randomizerMock.On("EventDidHappen", 0.5).Return(false)
+
+		got := UniformCrossover{}.Cross(mother, father, randomizerMock)
+
+		assert.Equal(t, []byte("BBBB"), got)
+	})
+}
+
+func TestOnePointCrossover(t *testing.T) {
+	t.Run("Should take the genes after the cut from the second parent", func(t *testing.T) {
+		randomizerMock := &RandomizerMock{}
+		randomizerMock.On("NumberBetween", 1, 5).Return(2)
+
+		got := OnePointCrossover{}.Cross([]byte("AAAAA"), []byte("BBBBB"), randomizerMock)
+
+		assert.Equal(t, []byte("AABBB"), got)
+	})
+
+	t.Run("Should copy a single gene genome", func(t *testing.T) {
+		got := OnePointCrossover{}.Cross([]byte("A"), []byte("B"), &RandomizerMock{})
+
+		assert.Equal(t, []byte("A"), got)
+	})
+}
+
+func TestTwoPointCrossover(t *testing.T) {
+	t.Run("Should take the genes between the cuts from the second parent", func(t *testing.T) {
+		randomizerMock := &RandomizerMock{}
+		randomizerMock.On("NumberBetween", 1, 5).Return(4).Once()
+		randomizerMock.On("NumberBetween", 1, 5).Return(1).Once()
+
+		got := TwoPointCrossover{}.Cross([]byte("AAAAA"), []byte("BBBBB"), randomizerMock)
+
+		assert.Equal(t, []byte("ABBBA"), got)
+		randomizerMock.AssertExpectations(t)
+	})
+}
+
+func TestBlockCrossover(t *testing.T) {
+	crossover := BlockCrossover{Blocks: []Block{
+		{Group: "hard", Start: 1, Length: 2},
+		{Group: "hard", Start: 3, Length: 2},
+	}}
+
+	t.Run("Should take every block whole from either parent", func(t *testing.T) {
+		randomizerMock := &RandomizerMock{}
+		randomizerMock.On("EventDidHappen", 0.5).Return(false).Once()
+		randomizerMock.On("EventDidHappen", 0.5).Return(true).Once()
+
+		got := crossover.Cross([]byte("AAAAAA"), []byte("BBBBBB"), randomizerMock)
+
+		assert.Equal(t, []byte("ABBAAA"), got)
+		randomizerMock.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,184 @@
+package genetics
+
+import (
+	"math"
+)
+
+type DiversityReport struct {
+	// Share of genes two candidates differ on, averaged over every pair
+	MeanHammingDistance float64
+	// Entropy of each gene over its most possible entropy, averaged
+	MeanGeneEntropy float64
+	UniqueGenotypes int
+	// See GenotypeDiversity
+	Diversity float64
+}
+
+// Public methods
+
+func MeasureDiversity(candidates []*Candidate) DiversityReport {
+	return DiversityReport{
+		MeanHammingDistance: MeanHammingDistance(candidates),
+		MeanGeneEntropy:     MeanGeneEntropy(candidates),
+		UniqueGenotypes:     UniqueGenotypes(candidates),
+		Diversity:           GenotypeDiversity(candidates),
+	}
+}
+
+// Share of candidates not carrying the most common base of a gene, averaged over the genes that can vary;
+// 0 when every candidate is the same, 1 when there are none to compare
+func GenotypeDiversity(candidates []*Candidate) float64 {
+	if len(candidates) < 2 {
+		return 1
+	}
+
+	genes := variableGenes(candidates)
+	if len(genes) == 0 {
+		return 0
+	}
+
+	diversity := 0.0
+	for _, gene := range genes {
+		mostCommon := 0
+		for _, count := range baseCounts(candidates, gene) {
+			if count > mostCommon {
+				mostCommon = count
+			}
+		}
+
+		diversity += 1 - float64(mostCommon)/float64(len(candidates))
+	}
+
+	return diversity / float64(len(genes))
+}
+
+// Share of the genes that can vary two candidates differ on, averaged over every pair; 0 without pairs
+func MeanHammingDistance(candidates []*Candidate) float64 {
+	genes := variableGenes(candidates)
+	if len(candidates) < 2 || len(genes) == 0 {
+		return 0
+	}
+
+	// pairs that differ on a gene are all pairs but those sharing its base
+	pairs := float64(len(candidates) * (len(candidates) - 1) / 2)
+	differing := 0.0
+	for _, gene := range genes {
+		same := 0
+		for _, count := range baseCounts(candidates, gene) {
+			same += count * (count - 1) / 2
+		}
+		differing += pairs - float64(same)
+	}
+
+	return differing / pairs / float64(len(genes))
+}
+
+// Shannon entropy in bits of every gene, over the population
+func GeneEntropy(candidates []*Candidate) []float64 {
+	if len(candidates) == 0 {
+		return []float64{}
+	}
+
+	entropy := make([]float64, len(candidates[0].Chromosome.raw))
+	for gene := range entropy {
+		for _, count := range baseCounts(candidates, gene) {
+			share := float64(count) / float64(len(candidates))
+			entropy[gene] -= share * math.Log2(share)
+		}
+	}
+
+	return entropy
+}
+
+// Entropy of each gene that can vary over the entropy of its bases all equally common, averaged; 0 for a converged population
+func MeanGeneEntropy(candidates []*Candidate) float64 {
+	genes := variableGenes(candidates)
+	if len(genes) == 0 {
+		return 0
+	}
+
+	entropy := GeneEntropy(candidates)
+	sequencing := candidates[0].Chromosome.sequencing
+	sum := 0.0
+	for _, gene := range genes {
+		bases := len(candidates)
+		if sequencing != nil {
+			bases = len(sequencing[gene])
+		}
+		sum += entropy[gene] / math.Log2(math.Max(float64(bases), 2))
+	}
+
+	return sum / float64(len(genes))
+}
+
+func UniqueGenotypes(candidates []*Candidate) int {
+	genotypes := map[string]bool{}
+	for _, candidate := range candidates {
+		genotypes[string(candidate.Chromosome.raw)] = true
+	}
+
+	return len(genotypes)
+}
+
+// Divides the fitness of every candidate by how crowded its niche is, to keep a population from converging too soon.
+// Candidates closer than the radius, a share of genes from 0 to 1, count towards each other's niche, the closer the more.
+func ShareFitness(candidates []*Candidate, radius float64) []*Candidate {
+	genes := variableGenes(candidates)
+	if radius <= 0 || len(genes) == 0 {
+		return withFitness(candidates, fitnessOf(candidates))
+	}
+
+	shared := make([]float64, len(candidates))
+	for i, candidate := range candidates {
+		niche := 0.0
+		for _, other := range candidates {
+			distance := hammingDistance(candidate.Chromosome, other.Chromosome, genes)
+			if distance < radius {
+				niche += 1 - distance/radius
+			}
+		}
+
+		shared[i] = candidate.Fitness / niche
+	}
+
+	return withFitness(candidates, shared)
+}
+
+// Helper methods
+
+// The genes that have more than one base, every gene when the sequencing is unknown
+func variableGenes(candidates []*Candidate) []int {
+	if len(candidates) == 0 {
+		return []int{}
+	}
+
+	first := candidates[0].Chromosome
+	genes := []int{}
+	for gene := range first.raw {
+		if first.sequencing == nil || len(first.sequencing[gene]) > 1 {
+			genes = append(genes, gene)
+		}
+	}
+
+	return genes
+}
+
+func baseCounts(candidates []*Candidate, gene int) map[byte]int {
+	counts := map[byte]int{}
+	for _, candidate := range candidates {
+		counts[candidate.Chromosome.raw[gene]]++
+	}
+
+	return counts
+}
+
+func hammingDistance(a *Chromosome, b *Chromosome, genes []int) float64 {
+	differing := 0
+	for _, gene := range genes {
+		if a.raw[gene] != b.raw[gene] {
+			differing++
+		}
+	}
+
+	return float64(differing) / float64(len(genes))
+}
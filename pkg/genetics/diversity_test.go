@@ -0,0 +1,121 @@
+package genetics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Helpers
+
+// The first gene never varies
+func diversityPopulation(genomes ...string) []*Candidate {
+	bases := []byte("ABC")
+	sequencing := [][]byte{[]byte("X"), bases, bases}
+
+	candidates := []*Candidate{}
+	for _, genome := range genomes {
+		candidates = append(candidates, &Candidate{NewChromosome([]byte(genome), sequencing), 1})
+	}
+
+	return candidates
+}
+
+// Tests
+
+func TestGenotypeDiversity(t *testing.T) {
+	population := diversityPopulation
+
+	testCases := []struct {
+		desc       string
+		candidates []*Candidate
+		want       float64
+	}{
+		{desc: "Should be 0 when every candidate is the same", candidates: population("XAB", "XAB", "XAB"), want: 0},
+		{desc: "Should count the candidates off the most common base", candidates: population("XAA", "XAB", "XBC", "XAA"), want: 0.375},
+		{desc: "Should be 1 without candidates to compare", candidates: population("XAB"), want: 1},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			assert.InDelta(t, tC.want, GenotypeDiversity(tC.candidates), 1e-9)
+		})
+	}
+}
+
+func TestDiversityMeasures(t *testing.T) {
+	mixed := diversityPopulation("XAA", "XAB", "XBC", "XAA")
+	converged := diversityPopulation("XAB", "XAB", "XAB")
+
+	t.Run("Should average the share of genes every pair differs on", func(t *testing.T) {
+		assert.InDelta(t, 8.0/12.0, MeanHammingDistance(mixed), 1e-9)
+		assert.Equal(t, 0.0, MeanHammingDistance(converged))
+		assert.Equal(t, 0.0, MeanHammingDistance(diversityPopulation("XAB")))
+	})
+
+	t.Run("Should measure the entropy of every gene in bits", func(t *testing.T) {
+		entropy := GeneEntropy(mixed)
+
+		assert.Len(t, entropy, 3)
+		assert.InDelta(t, 0.0, entropy[0], 1e-9)
+		assert.InDelta(t, 0.811278, entropy[1], 1e-6)
+		assert.InDelta(t, 1.5, entropy[2], 1e-9)
+	})
+
+	t.Run("Should average the entropy of the genes that can vary over their most possible", func(t *testing.T) {
+		assert.InDelta(t, 0.729127, MeanGeneEntropy(mixed), 1e-6)
+		assert.Equal(t, 0.0, MeanGeneEntropy(converged))
+	})
+
+	t.Run("Should count unique genotypes", func(t *testing.T) {
+		assert.Equal(t, 3, UniqueGenotypes(mixed))
+		assert.Equal(t, 1, UniqueGenotypes(converged))
+		assert.Equal(t, 0, UniqueGenotypes([]*Candidate{}))
+	})
+
+	t.Run("Should report every measure", func(t *testing.T) {
+		report := MeasureDiversity(mixed)
+
+		assert.Equal(t, 3, report.UniqueGenotypes)
+		assert.InDelta(t, 0.375, report.Diversity, 1e-9)
+		assert.InDelta(t, MeanHammingDistance(mixed), report.MeanHammingDistance, 1e-9)
+		assert.InDelta(t, MeanGeneEntropy(mixed), report.MeanGeneEntropy, 1e-9)
+	})
+}
+
+func TestShareFitness(t *testing.T) {
+	testCases := []struct {
+		desc       string
+		candidates []*Candidate
+		radius     float64
+		want       []float64
+	}{
+		{
+			desc:       "Should divide fitness among identical candidates",
+			candidates: diversityPopulation("XAA", "XAA", "XBC"),
+			radius:     0.5,
+			want:       []float64{0.5, 0.5, 1},
+		},
+		{
+			desc:       "Should share less with candidates further away",
+			candidates: diversityPopulation("XAA", "XAB"),
+			radius:     1,
+			want:       []float64{1 / 1.5, 1 / 1.5},
+		},
+		{
+			desc:       "Should leave fitness alone without a radius",
+			candidates: diversityPopulation("XAA", "XAA"),
+			radius:     0,
+			want:       []float64{1, 1},
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			shared := ShareFitness(tC.candidates, tC.radius)
+
+			assert.InDeltaSlice(t, tC.want, fitnessOf(shared), 1e-9)
+			assert.Equal(t, 1.0, tC.candidates[0].Fitness, "the candidates are left untouched")
+		})
+	}
+}
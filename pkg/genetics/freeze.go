@@ -0,0 +1,10 @@
+package genetics
+
+// The value each gene is held at, genes set to Unfrozen evolve freely
+type FreezeMask []byte
+
+const Unfrozen byte = 0
+
+func (mask FreezeMask) IsFrozen(gene int) bool {
+	return gene < len(mask) && mask[gene] != Unfrozen
+}
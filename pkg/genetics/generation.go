@@ -1,6 +1,7 @@
 package genetics
 
 import (
+	"math"
 	"sort"
 
 	"github.com/samber/lo"
@@ -15,17 +16,50 @@ type GenerationOptions struct {
 	PopulationSize int
 	MutationRate   float64
 	CutoffRate     float64
+	// Optional, offspring and random candidates are repaired to satisfy it
+	Constraint Constraint
+	// Optional, genes held at a given value in every candidate
+	Freeze FreezeMask
+	// Optional, raw chromosomes the first generation starts from
+	Seeds [][]byte
+	// Share of the first generation made of seeds and their perturbed copies, the rest is random
+	SeedRate float64
+	// Mutation rate of the perturbed copies of the seeds
+	SeedMutationRate float64
+	// Optional, picks parents instead of the fitness cutoff and fitness probabilities
+	Selector Selectorish
+	// With a Selector, share of the next generation cloned from selected candidates; the rest are their offspring
+	CloneRate float64
+	// Optional, how the genes of two parents are combined; uniform when unset
+	Crossover Crossoverish
+	// Further mutation of the offspring, after crossover
+	Mutation MutationOptions
+	// The number of fittest candidates copied unchanged into the next generation
+	Elitism int
+	// Optional, how fitness is brought between 0 and 1; over the maximum when unset
+	Scaling Scalerish
+	// Optional, the mutation rate of each generation instead of MutationRate
+	Schedule MutationScheduleish
+	// The generation being built, counting from 0, for the schedule
+	Generation int
+	// Every candidate carries its own mutation rate in RateGene, starting at the effective one, adapted by its offspring
+	SelfAdaptive bool
+	// With SelfAdaptive, the gene carrying the rate, taking RateGeneBases
+	RateGene int
+	// Optional, fitness is shared among candidates closer than this share of genes, from 0 to 1
+	SharingRadius float64
 }
 
+// Fitness over the maximum; zero, negative, NaN and infinite fitness at 0, and every fitness at 0 when none is positive
 func NormalizeFitnessList(candidates []*Candidate) []*Candidate {
-	maxFitness := lo.Reduce(candidates, func(acc float64, candidate *Candidate, _ int) float64 {
-		return lo.Max([]float64{acc, candidate.Fitness})
-	}, 0.0)
-
-	return lo.Map(candidates, func(candidate *Candidate, _ int) *Candidate {
-		normalizedFitness := candidate.Fitness / maxFitness
-		return &Candidate{candidate.Chromosome, normalizedFitness}
+	fitness := lo.Map(finiteFitness(candidates), func(value float64, _ int) float64 {
+		return lo.Ternary(math.IsNaN(value), 0, math.Max(value, 0))
 	})
+	maxFitness := lo.Max(append(fitness, 0.0))
+
+	return withFitness(candidates, lo.Map(fitness, func(value float64, _ int) float64 {
+		return lo.Ternary(maxFitness > 0, value/maxFitness, 0)
+	}))
 }
 
 func SortByFitness(candidates []*Candidate) {
@@ -40,6 +74,15 @@ func RemoveWorstPerformers(candidates []*Candidate, cutoffRate float64) []*Candi
 	})
 }
 
+// The fittest candidates, sorted by fitness, to carry over unchanged
+func Elites(sorted []*Candidate, elitism int) []*Candidate {
+	count := lo.Clamp(elitism, 0, len(sorted))
+
+	return lo.Map(sorted[:count], func(candidate *Candidate, _ int) *Candidate {
+		return &Candidate{candidate.Chromosome, -1.0}
+	})
+}
+
 func Parthenogenesis(candidates []*Candidate, randomizer Randomizerish) []*Candidate {
 	filtered := lo.Filter(candidates, func(candidate *Candidate, _ int) bool {
 		return randomizer.EventDidHappen(candidate.Fitness)
@@ -56,10 +99,14 @@ func Crossover(candidates []*Candidate, options GenerationOptions, randomizer Ra
 
 	for i := 0; i < len(candidates); i++ {
 		for j := i + 1; j < len(candidates); j++ {
+			if len(newCandidates) >= options.PopulationSize {
+				return newCandidates
+			}
+
 			if randomizer.EventDidHappen(candidates[i].Fitness * candidates[j].Fitness) {
-				numberOfChildren := randomizer.NumberBetween(1, 10)
+				numberOfChildren := lo.Min([]int{randomizer.NumberBetween(1, 10), options.PopulationSize - len(newCandidates)})
 				for k := 0; k < numberOfChildren; k++ {
-					newGuy := candidates[i].Chromosome.Merge(candidates[j].Chromosome, mutationRate, randomizer)
+					newGuy := candidates[i].Chromosome.MergeWith(candidates[j].Chromosome, crossoverOperator(options), mutationRate, randomizer).MutateWith(options.Mutation, randomizer).Repair(options.Constraint, randomizer).Freeze(options.Freeze)
 					newCandidates = append(newCandidates, &Candidate{newGuy, -1.0})
 				}
 			}
@@ -69,6 +116,34 @@ func Crossover(candidates []*Candidate, options GenerationOptions, randomizer Ra
 	return newCandidates
 }
 
+// Clones and offspring of the candidates the selector picks, as many as the population size
+func SelectedOffspring(candidates []*Candidate, options GenerationOptions, randomizer Randomizerish) []*Candidate {
+	if options.PopulationSize <= 0 {
+		return []*Candidate{}
+	}
+
+	clonesCount := int(math.Round(float64(options.PopulationSize) * math.Min(options.CloneRate, 1.0)))
+	childrenCount := options.PopulationSize - clonesCount
+
+	clones := lo.Map(options.Selector.Select(candidates, clonesCount, randomizer), func(candidate *Candidate, _ int) *Candidate {
+		return &Candidate{candidate.Chromosome, -1.0}
+	})
+
+	parents := options.Selector.Select(candidates, 2*childrenCount, randomizer)
+	children := []*Candidate{}
+	for i := 0; i < childrenCount; i++ {
+		mother, father := parents[2*i], parents[2*i+1]
+		if father.Fitness > mother.Fitness {
+			mother, father = father, mother
+		}
+
+		newGuy := mother.Chromosome.MergeWith(father.Chromosome, crossoverOperator(options), options.MutationRate, randomizer).MutateWith(options.Mutation, randomizer).Repair(options.Constraint, randomizer).Freeze(options.Freeze)
+		children = append(children, &Candidate{newGuy, -1.0})
+	}
+
+	return append(clones, children...)
+}
+
 func SpontaneousGeneration(populationSize int, sequencing [][]byte, randomizer Randomizerish) []*Candidate {
 	population := []*Candidate{}
 	for i := 0; i < populationSize; i++ {
@@ -82,22 +157,101 @@ func SpontaneousGeneration(populationSize int, sequencing [][]byte, randomizer R
 	return population
 }
 
+// Every seed as is, then perturbed copies of them in turn, up to count candidates
+func SeededGeneration(seeds [][]byte, count int, sequencing [][]byte, mutationRate float64, randomizer Randomizerish) []*Candidate {
+	population := []*Candidate{}
+	if len(seeds) == 0 {
+		return population
+	}
+
+	for i := 0; i < count; i++ {
+		seed := seeds[i%len(seeds)]
+		chromosome := NewChromosome(append([]byte{}, seed...), sequencing)
+		if i >= len(seeds) {
+			chromosome.Mutate(mutationRate, randomizer)
+		}
+
+		population = append(population, &Candidate{chromosome, -1.0})
+	}
+
+	return population
+}
+
 func NewGenerationFromPrevious(previous []*Candidate, sequencing [][]byte, options GenerationOptions, randomizer Randomizerish) []*Candidate {
-	normalized := NormalizeFitnessList(previous)
+	options.MutationRate = EffectiveMutationRate(previous, options)
+	normalized := scalingOperator(options).Scale(previous)
 	SortByFitness(normalized)
-	filtered := RemoveWorstPerformers(normalized, options.CutoffRate)
 
-	generation := []*Candidate{}
+	generation := Elites(normalized, lo.Min([]int{options.Elitism, options.PopulationSize}))
+	remaining := options
+	remaining.PopulationSize = options.PopulationSize - len(generation)
+
+	// elites are picked on their own fitness, the rest on the fitness shared with their niche;
+	// sharing keeps the order, so the elites are still the first of the pool
+	pool := normalized
+	if options.SharingRadius > 0 {
+		pool = NormalizeFitnessList(ShareFitness(normalized, options.SharingRadius))
+	}
+
+	if options.Selector != nil && len(pool) > 0 {
+		generation = append(generation, SelectedOffspring(pool, remaining, randomizer)...)
+	} else {
+		// the elites are carried over already
+		parthenogenesis := Parthenogenesis(RemoveWorstPerformers(pool[len(generation):], options.CutoffRate), randomizer)
+		generation = append(generation, parthenogenesis...)
+
+		filtered := RemoveWorstPerformers(pool, options.CutoffRate)
+		SortByFitness(filtered)
+
+		remaining.PopulationSize -= len(parthenogenesis)
+		crossover := Crossover(filtered, remaining, randomizer)
+		generation = append(generation, crossover...)
+	}
+
+	if len(generation) > options.PopulationSize {
+		generation = generation[:options.PopulationSize]
+	}
+
+	newcomers := []*Candidate{}
+	if len(previous) == 0 {
+		seededCount := int(math.Round(float64(options.PopulationSize) * math.Min(options.SeedRate, 1.0)))
+		newcomers = append(newcomers, SeededGeneration(options.Seeds, seededCount, sequencing, options.SeedMutationRate, randomizer)...)
+	}
 
-	parthenogenesis := Parthenogenesis(filtered, randomizer)
-	generation = append(generation, parthenogenesis...)
+	seeded := len(newcomers)
+	remainingSpace := options.PopulationSize - len(generation) - len(newcomers)
+	newcomers = append(newcomers, SpontaneousGeneration(remainingSpace, sequencing, randomizer)...)
 
-	crossover := Crossover(filtered, options, randomizer)
-	generation = append(generation, crossover...)
+	for i, candidate := range newcomers {
+		candidate.Chromosome.Repair(options.Constraint, randomizer).Freeze(options.Freeze)
 
-	remainingSpace := options.PopulationSize - len(generation)
-	spontaneousGeneration := SpontaneousGeneration(remainingSpace, sequencing, randomizer)
-	generation = append(generation, spontaneousGeneration...)
+		// seeds keep the rate they were saved with, random newcomers start at the effective one
+		rate := math.Max(options.MutationRate, MinSelfAdaptiveRate)
+		if options.SelfAdaptive && i < seeded {
+			candidate.Chromosome.SelfAdaptive(options.RateGene, rate)
+		} else if options.SelfAdaptive {
+			candidate.Chromosome.WithMutationRate(options.RateGene, rate)
+		}
+	}
+	generation = append(generation, newcomers...)
 
 	return generation
 }
+
+// Helper methods
+
+func crossoverOperator(options GenerationOptions) Crossoverish {
+	if options.Crossover == nil {
+		return UniformCrossover{}
+	}
+
+	return options.Crossover
+}
+
+func scalingOperator(options GenerationOptions) Scalerish {
+	if options.Scaling == nil {
+		return MaxScaling{}
+	}
+
+	return options.Scaling
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestNormalizeFitnessList(t *testing.T) {
@@ -62,6 +63,27 @@ func TestRemoveWorstPerformers(t *testing.T) {
 	})
 }
 
+func TestElites(t *testing.T) {
+	sorted := []*Candidate{
+		{Chromosome: &Chromosome{raw: []byte("AAA")}, Fitness: 1.0},
+		{Chromosome: &Chromosome{raw: []byte("BBB")}, Fitness: 0.5},
+	}
+
+	t.Run("Should carry over the fittest candidates", func(t *testing.T) {
+		want := []*Candidate{
+			{Chromosome: sorted[0].Chromosome, Fitness: -1.0},
+		}
+		got := Elites(sorted, 1)
+
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("Should carry over no more candidates than there are", func(t *testing.T) {
+		assert.Len(t, Elites(sorted, 5), 2)
+		assert.Empty(t, Elites(sorted, 0))
+	})
+}
+
 func TestParthenogenesis(t *testing.T) {
 	t.Run("Should create a new population by cloning the best candidates", func(t *testing.T) {
 		randomizerMock := &RandomizerMock{}
@@ -132,35 +154,36 @@ func TestCrossover(t *testing.T) {
 		randomizerMock.AssertExpectations(t)
 	})
 
-	// t.Run("Should not exceed max candidates", func(t *testing.T) {
-	// 	options := GenerationOptions{
-	// 		PopulationSize: 2,
-	// 		MutationRate:   0.1,
-	// 	}
-	// 	randomizerMock := &RandomizerMock{}
-	// 	// accept all mates
-	// 	randomizerMock.On("EventDidHappen", 0.5).Return(true)
-	// 	randomizerMock.On("EventDidHappen", 0.02).Return(true)
-	// 	randomizerMock.On("EventDidHappen", 0.0).Return(true)
-	// 	randomizerMock.On("EventDidHappen", 0.01).Return(true)
-	// 	// always produce max children
-	// 	randomizerMock.On("NumberBetween", mock.Anything, mock.Anything).Return(10)
-	// 	// do not mutate
-	// 	randomizerMock.On("EventDidHappen", options.MutationRate).Return(false)
-
-	// 	input := []*Candidate{
-	// 		{Chromosome: &Chromosome{raw: []byte("AAA")}, Fitness: 1.0},
-	// 		{Chromosome: &Chromosome{raw: []byte("BBB")}, Fitness: 0.5},
-	// 		{Chromosome: &Chromosome{raw: []byte("CCC")}, Fitness: 0.02},
-	// 		{Chromosome: &Chromosome{raw: []byte("DDD")}, Fitness: 0.0},
-	// 	}
-
-	// 	want := 2
-	// 	got := len(Crossover(input, options, randomizerMock))
-
-	// 	assert.Equal(t, want, got)
-	// 	randomizerMock.AssertExpectations(t)
-	// })
+	t.Run("Should not exceed max candidates", func(t *testing.T) {
+		options := GenerationOptions{
+			PopulationSize: 2,
+			MutationRate:   0.1,
+		}
+		randomizerMock := &RandomizerMock{}
+		// accept all mates
+		randomizerMock.On("EventDidHappen", 0.5).Return(true)
+		// the population is full before these pairs are considered
+		randomizerMock.On("EventDidHappen", 0.02).Return(true).Maybe()
+		randomizerMock.On("EventDidHappen", 0.0).Return(true).Maybe()
+		randomizerMock.On("EventDidHappen", 0.01).Return(true).Maybe()
+		// always produce max children
+		randomizerMock.On("NumberBetween", mock.Anything, mock.Anything).Return(10)
+		// do not mutate
+		randomizerMock.On("EventDidHappen", options.MutationRate).Return(false)
+
+		input := []*Candidate{
+			{Chromosome: &Chromosome{raw: []byte("AAA")}, Fitness: 1.0},
+			{Chromosome: &Chromosome{raw: []byte("BBB")}, Fitness: 0.5},
+			{Chromosome: &Chromosome{raw: []byte("CCC")}, Fitness: 0.02},
+			{Chromosome: &Chromosome{raw: []byte("DDD")}, Fitness: 0.0},
+		}
+
+		want := 2
+		got := len(Crossover(input, options, randomizerMock))
+
+		assert.Equal(t, want, got)
+		randomizerMock.AssertExpectations(t)
+	})
 }
 
 func TestSpontaneousGeneration(t *testing.T) {
@@ -174,9 +197,9 @@ func TestSpontaneousGeneration(t *testing.T) {
 		sequencing := [][]byte{bases, bases, bases}
 
 		want := []*Candidate{
-			{&Chromosome{[]byte("AAA"), sequencing}, -1.0},
-			{&Chromosome{[]byte("AAA"), sequencing}, -1.0},
-			{&Chromosome{[]byte("AAA"), sequencing}, -1.0},
+			{&Chromosome{[]byte("AAA"), sequencing, nil, false, 0}, -1.0},
+			{&Chromosome{[]byte("AAA"), sequencing, nil, false, 0}, -1.0},
+			{&Chromosome{[]byte("AAA"), sequencing, nil, false, 0}, -1.0},
 		}
 		got := SpontaneousGeneration(population, sequencing, randomizerMock)
 
@@ -185,6 +208,76 @@ func TestSpontaneousGeneration(t *testing.T) {
 	})
 }
 
+func TestSeededGeneration(t *testing.T) {
+	bases := []byte("ABC")
+	sequencing := [][]byte{bases, bases, bases}
+	seeds := [][]byte{[]byte("AAA"), []byte("BBB")}
+
+	t.Run("Should copy every seed, then perturb copies of them in turn", func(t *testing.T) {
+		randomizerMock := &RandomizerMock{}
+		// mutate only the first gene of each copy
+		randomizerMock.On("EventDidHappen", 0.2).Return(true).Once()
+		randomizerMock.On("EventDidHappen", 0.2).Return(false).Times(2)
+		randomizerMock.On("EventDidHappen", 0.2).Return(true).Once()
+		randomizerMock.On("EventDidHappen", 0.2).Return(false).Times(2)
+		randomizerMock.On("PickOne", bases).Return(bases[2]).Times(2)
+
+		want := []*Candidate{
+			{&Chromosome{[]byte("AAA"), sequencing, nil, false, 0}, -1.0},
+			{&Chromosome{[]byte("BBB"), sequencing, nil, false, 0}, -1.0},
+			{&Chromosome{[]byte("CAA"), sequencing, nil, false, 0}, -1.0},
+			{&Chromosome{[]byte("CBB"), sequencing, nil, false, 0}, -1.0},
+		}
+		got := SeededGeneration(seeds, 4, sequencing, 0.2, randomizerMock)
+
+		assert.Equal(t, want, got)
+		randomizerMock.AssertExpectations(t)
+	})
+
+	t.Run("Should not share the genome of the seeds", func(t *testing.T) {
+		got := SeededGeneration(seeds, 1, sequencing, 0.2, &RandomizerMock{})
+		got[0].Chromosome.Raw()[0] = 'C'
+
+		assert.Equal(t, []byte("AAA"), seeds[0])
+	})
+
+	t.Run("Should create nothing without seeds", func(t *testing.T) {
+		got := SeededGeneration([][]byte{}, 4, sequencing, 0.2, &RandomizerMock{})
+
+		assert.Empty(t, got)
+	})
+}
+
+func TestSelectedOffspring(t *testing.T) {
+	bases := []byte("ABC")
+	sequencing := [][]byte{bases, bases, bases}
+	a := &Candidate{&Chromosome{[]byte("AAA"), sequencing, nil, false, 0}, 0.5}
+	b := &Candidate{&Chromosome{[]byte("BBB"), sequencing, nil, false, 0}, 1.0}
+	candidates := []*Candidate{b, a}
+
+	t.Run("Should clone and mate the candidates the selector picks, the fitter parent first", func(t *testing.T) {
+		selectorMock := &SelectorMock{}
+		randomizerMock := &RandomizerMock{}
+		selectorMock.On("Select", candidates, 1, randomizerMock).Return([]*Candidate{b})
+		selectorMock.On("Select", candidates, 4, randomizerMock).Return([]*Candidate{a, b, a, a})
+		// children take every gene from their first parent, unmutated
+		randomizerMock.On("EventDidHappen", 0.5).Return(true)
+		randomizerMock.On("EventDidHappen", 0.1).Return(false)
+
+		options := GenerationOptions{PopulationSize: 3, MutationRate: 0.1, Selector: selectorMock, CloneRate: 0.34}
+
+		want := []*Candidate{
+			{&Chromosome{[]byte("BBB"), sequencing, nil, false, 0}, -1.0},
+			{&Chromosome{[]byte("BBB"), sequencing, nil, false, 0}, -1.0},
+			{&Chromosome{[]byte("AAA"), sequencing, nil, false, 0}, -1.0},
+		}
+		got := SelectedOffspring(candidates, options, randomizerMock)
+
+		assert.Equal(t, want, got)
+		selectorMock.AssertExpectations(t)
+	})
+}
+
 func TestNewGenerationFromPrevious(t *testing.T) {
 	bases := []byte("ABC")
 	sequencing := [][]byte{bases, bases, bases}
@@ -201,9 +294,9 @@ func TestNewGenerationFromPrevious(t *testing.T) {
 		randomizerMock.On("PickOne", bases).Return(bases[0]).Times(9)
 
 		want := []*Candidate{
-			{&Chromosome{[]byte("AAA"), sequencing}, -1.0},
-			{&Chromosome{[]byte("AAA"), sequencing}, -1.0},
-			{&Chromosome{[]byte("AAA"), sequencing}, -1.0},
+			{&Chromosome{[]byte("AAA"), sequencing, nil, false, 0}, -1.0},
+			{&Chromosome{[]byte("AAA"), sequencing, nil, false, 0}, -1.0},
+			{&Chromosome{[]byte("AAA"), sequencing, nil, false, 0}, -1.0},
 		}
 
 		got := NewGenerationFromPrevious(previous, sequencing, options, randomizerMock)
@@ -212,9 +305,133 @@ func TestNewGenerationFromPrevious(t *testing.T) {
 		randomizerMock.AssertExpectations(t)
 	})
 
+	t.Run("Should repair new candidates to satisfy the constraint", func(t *testing.T) {
+		previous := []*Candidate{}
+		constrained := options
+		// no A on the first gene
+		constrained.Constraint = func(gene int, base byte) bool { return gene != 0 || base != 'A' }
+
+		randomizerMock := &RandomizerMock{}
+		randomizerMock.On("PickOne", bases).Return(bases[0]).Times(9)
+		randomizerMock.On("PickOne", []byte("BC")).Return(bases[1]).Times(3)
+
+		want := []*Candidate{
+			{&Chromosome{[]byte("BAA"), sequencing, nil, false, 0}, -1.0},
+			{&Chromosome{[]byte("BAA"), sequencing, nil, false, 0}, -1.0},
+			{&Chromosome{[]byte("BAA"), sequencing, nil, false, 0}, -1.0},
+		}
+
+		got := NewGenerationFromPrevious(previous, sequencing, constrained, randomizerMock)
+
+		assert.Equal(t, want, got)
+		randomizerMock.AssertExpectations(t)
+	})
+
+	t.Run("Should hold frozen genes in new candidates", func(t *testing.T) {
+		previous := []*Candidate{}
+		frozen := options
+		frozen.Freeze = FreezeMask{Unfrozen, Unfrozen, 'C'}
+
+		randomizerMock := &RandomizerMock{}
+		randomizerMock.On("PickOne", bases).Return(bases[0]).Times(9)
+
+		got := NewGenerationFromPrevious(previous, sequencing, frozen, randomizerMock)
+
+		for _, candidate := range got {
+			assert.Equal(t, []byte("AAC"), candidate.Chromosome.Raw())
+		}
+		randomizerMock.AssertExpectations(t)
+	})
+
+	t.Run("Should mix seeds and random candidates in the first generation", func(t *testing.T) {
+		previous := []*Candidate{}
+		seeded := options
+		seeded.Seeds = [][]byte{[]byte("BBB")}
+		seeded.SeedRate = 0.34
+
+		randomizerMock := &RandomizerMock{}
+		randomizerMock.On("PickOne", bases).Return(bases[0]).Times(6)
+
+		want := []*Candidate{
+			{&Chromosome{[]byte("BBB"), sequencing, nil, false, 0}, -1.0},
+			{&Chromosome{[]byte("AAA"), sequencing, nil, false, 0}, -1.0},
+			{&Chromosome{[]byte("AAA"), sequencing, nil, false, 0}, -1.0},
+		}
+
+		got := NewGenerationFromPrevious(previous, sequencing, seeded, randomizerMock)
+
+		assert.Equal(t, want, got)
+		randomizerMock.AssertExpectations(t)
+	})
+
+	t.Run("Should always keep the champion", func(t *testing.T) {
+		previous := []*Candidate{
+			{&Chromosome{[]byte("AAA"), sequencing, nil, false, 0}, 600.0},
+			{&Chromosome{[]byte("BBB"), sequencing, nil, false, 0}, 1000.0},
+		}
+		elitist := options
+		elitist.Elitism = 1
+
+		randomizerMock := &RandomizerMock{}
+		// neither clone nor mate anyone
+		randomizerMock.On("EventDidHappen", 0.6).Return(false)
+		randomizerMock.On("PickOne", bases).Return(bases[2]).Times(6)
+
+		want := []*Candidate{
+			{&Chromosome{[]byte("BBB"), sequencing, nil, false, 0}, -1.0},
+			{&Chromosome{[]byte("CCC"), sequencing, nil, false, 0}, -1.0},
+			{&Chromosome{[]byte("CCC"), sequencing, nil, false, 0}, -1.0},
+		}
+
+		got := NewGenerationFromPrevious(previous, sequencing, elitist, randomizerMock)
+
+		assert.Equal(t, want, got)
+		randomizerMock.AssertExpectations(t)
+	})
+
+	t.Run("Should carry over every non-elite once when fitness is shared", func(t *testing.T) {
+		previous := []*Candidate{
+			{&Chromosome{[]byte("AAA"), sequencing, nil, false, 0}, 1.0},
+			{&Chromosome{[]byte("AAB"), sequencing, nil, false, 0}, 0.95},
+			{&Chromosome{[]byte("CCC"), sequencing, nil, false, 0}, 0.9},
+		}
+		sharing := options
+		sharing.CutoffRate = 0
+		sharing.Elitism = 1
+		// AAA and AAB share a niche, so CCC comes out fittest once shared
+		sharing.SharingRadius = 0.5
+
+		randomizerMock := &RandomizerMock{}
+		// clone everyone
+		randomizerMock.On("EventDidHappen", mock.Anything).Return(true)
+
+		got := NewGenerationFromPrevious(previous, sequencing, sharing, randomizerMock)
+
+		assert.Len(t, got, 3)
+		assert.Same(t, previous[0].Chromosome, got[0].Chromosome)
+		assert.ElementsMatch(t, []*Chromosome{previous[1].Chromosome, previous[2].Chromosome}, []*Chromosome{got[1].Chromosome, got[2].Chromosome})
+	})
+
+	t.Run("Should return exactly the population size", func(t *testing.T) {
+		previous := []*Candidate{
+			{&Chromosome{[]byte("AAA"), sequencing, nil, false, 0}, 1.0},
+			{&Chromosome{[]byte("BBB"), sequencing, nil, false, 0}, 1.0},
+			{&Chromosome{[]byte("CCC"), sequencing, nil, false, 0}, 1.0},
+			{&Chromosome{[]byte("ABC"), sequencing, nil, false, 0}, 1.0},
+		}
+
+		randomizerMock := &RandomizerMock{}
+		// clone and mate everyone
+		randomizerMock.On("EventDidHappen", 1.0).Return(true)
+
+		got := NewGenerationFromPrevious(previous, sequencing, options, randomizerMock)
+
+		assert.Len(t, got, options.PopulationSize)
+	})
+
 	t.Run("Should create a new generation from a single candidate", func(t *testing.T) {
 		previous := []*Candidate{
-			{&Chromosome{[]byte("BBB"), sequencing}, 1.0},
+			{&Chromosome{[]byte("BBB"), sequencing, nil, false, 0}, 1.0},
 		}
 
 		randomizerMock := &RandomizerMock{}
@@ -222,9 +439,9 @@ func TestNewGenerationFromPrevious(t *testing.T) {
 		randomizerMock.On("PickOne", bases).Return(bases[0]).Times(6)
 
 		want := []*Candidate{
-			{&Chromosome{[]byte("BBB"), sequencing}, -1.0},
-			{&Chromosome{[]byte("AAA"), sequencing}, -1.0},
-			{&Chromosome{[]byte("AAA"), sequencing}, -1.0},
+			{&Chromosome{[]byte("BBB"), sequencing, nil, false, 0}, -1.0},
+			{&Chromosome{[]byte("AAA"), sequencing, nil, false, 0}, -1.0},
+			{&Chromosome{[]byte("AAA"), sequencing, nil, false, 0}, -1.0},
 		}
 
 		got := NewGenerationFromPrevious(previous, sequencing, options, randomizerMock)
@@ -235,9 +452,9 @@ func TestNewGenerationFromPrevious(t *testing.T) {
 
 	t.Run("Should create a new generation from a list of candidates", func(t *testing.T) {
 		previous := []*Candidate{
-			{&Chromosome{[]byte("BBB"), sequencing}, 1000.0},
-			{&Chromosome{[]byte("AAA"), sequencing}, 600.0},
-			{&Chromosome{[]byte("CCC"), sequencing}, 2.0},
+			{&Chromosome{[]byte("BBB"), sequencing, nil, false, 0}, 1000.0},
+			{&Chromosome{[]byte("AAA"), sequencing, nil, false, 0}, 600.0},
+			{&Chromosome{[]byte("CCC"), sequencing, nil, false, 0}, 2.0},
 		}
 
 		randomizerMock := &RandomizerMock{}
@@ -258,9 +475,9 @@ func TestNewGenerationFromPrevious(t *testing.T) {
 		randomizerMock.On("PickOne", bases).Return(bases[0]).Once()
 
 		want := []*Candidate{
-			{&Chromosome{[]byte("BBB"), sequencing}, -1.0},
-			{&Chromosome{[]byte("BBA"), sequencing}, -1.0},
-			{&Chromosome{[]byte("ABA"), sequencing}, -1.0},
+			{&Chromosome{[]byte("BBB"), sequencing, nil, false, 0}, -1.0},
+			{&Chromosome{[]byte("BBA"), sequencing, nil, false, 0}, -1.0},
+			{&Chromosome{[]byte("ABA"), sequencing, nil, false, 0}, -1.0},
 		}
 
 		got := NewGenerationFromPrevious(previous, sequencing, options, randomizerMock)
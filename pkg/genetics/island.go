@@ -0,0 +1,127 @@
+package genetics
+
+import (
+	"sync"
+
+	"github.com/samber/lo"
+)
+
+// A population evolving on its own, with its own options and random stream
+type Island struct {
+	Population []*Candidate
+	Options    GenerationOptions
+	Randomizer Randomizerish
+}
+
+type MigrationTopologyish interface {
+	// The islands the migrants of an island go to
+	Destinations(island int, islands int) []int
+}
+
+// Every island sends its migrants to the next one, the last to the first
+type RingTopology struct{}
+
+// Every island sends its migrants to every other one
+type FullyConnectedTopology struct{}
+
+type MigrationOptions struct {
+	// Generations between migrations, never when zero
+	Interval int
+	// The fittest candidates of each island that migrate
+	Migrants int
+	// Optional, where the migrants go; a ring when unset
+	Topology MigrationTopologyish
+}
+
+// Public methods
+
+func (topology RingTopology) Destinations(island int, islands int) []int {
+	if islands < 2 {
+		return []int{}
+	}
+
+	return []int{(island + 1) % islands}
+}
+
+func (topology FullyConnectedTopology) Destinations(island int, islands int) []int {
+	destinations := []int{}
+	for destination := 0; destination < islands; destination++ {
+		if destination != island {
+			destinations = append(destinations, destination)
+		}
+	}
+
+	return destinations
+}
+
+func MigrationIsDue(generation int, options MigrationOptions) bool {
+	return options.Interval > 0 && generation > 0 && generation%options.Interval == 0
+}
+
+// Copies the fittest candidates of every island over the least fit of its destinations; islands keep their size
+func Migrate(populations [][]*Candidate, options MigrationOptions) [][]*Candidate {
+	// everyone leaves at once, so migrants don't travel twice; they keep their fitness to compete on arrival
+	emigrants := lo.Map(populations, func(population []*Candidate, _ int) []*Candidate {
+		fittest := sortedByFitness(population)[:lo.Clamp(options.Migrants, 0, len(population))]
+		return withFitness(fittest, fitnessOf(fittest))
+	})
+
+	immigrants := make([][]*Candidate, len(populations))
+	for island := range populations {
+		for _, destination := range topologyOperator(options).Destinations(island, len(populations)) {
+			immigrants[destination] = append(immigrants[destination], emigrants[island]...)
+		}
+	}
+
+	migrated := make([][]*Candidate, len(populations))
+	for island, population := range populations {
+		settlers := sortedByFitness(population)
+		arrivals := immigrants[island][:lo.Min([]int{len(immigrants[island]), len(settlers)})]
+
+		migrated[island] = append(settlers[:len(settlers)-len(arrivals)], arrivals...)
+	}
+
+	return migrated
+}
+
+// Migrates when due, then builds and evaluates the next generation of every island at once.
+// Evaluation runs on its own goroutine per island and returns the candidates with their fitness.
+func EvolveIslands(islands []*Island, sequencing [][]byte, generation int, migration MigrationOptions, evaluate func(island int, generation []*Candidate) []*Candidate) {
+	if MigrationIsDue(generation, migration) {
+		populations := lo.Map(islands, func(island *Island, _ int) []*Candidate { return island.Population })
+		for i, population := range Migrate(populations, migration) {
+			islands[i].Population = population
+		}
+	}
+
+	var waitGroup sync.WaitGroup
+	for i, island := range islands {
+		waitGroup.Add(1)
+		go func(i int, island *Island) {
+			defer waitGroup.Done()
+
+			options := island.Options
+			options.Generation = generation
+			next := NewGenerationFromPrevious(island.Population, sequencing, options, island.Randomizer)
+			island.Population = evaluate(i, next)
+		}(i, island)
+	}
+	waitGroup.Wait()
+}
+
+// Helper methods
+
+func sortedByFitness(candidates []*Candidate) []*Candidate {
+	sorted := append([]*Candidate{}, candidates...)
+	SortByFitness(sorted)
+
+	return sorted
+}
+
+func topologyOperator(options MigrationOptions) MigrationTopologyish {
+	if options.Topology == nil {
+		return RingTopology{}
+	}
+
+	return options.Topology
+}
@@ -0,0 +1,140 @@
+package genetics
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMigrationTopologies(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		topology MigrationTopologyish
+		island   int
+		islands  int
+		want     []int
+	}{
+		{desc: "Should send to the next island on a ring", topology: RingTopology{}, island: 1, islands: 3, want: []int{2}},
+		{desc: "Should send from the last island to the first on a ring", topology: RingTopology{}, island: 2, islands: 3, want: []int{0}},
+		{desc: "Should not send anywhere on a ring of one", topology: RingTopology{}, island: 0, islands: 1, want: []int{}},
+		{desc: "Should send to every other island when fully connected", topology: FullyConnectedTopology{}, island: 1, islands: 3, want: []int{0, 2}},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			assert.Equal(t, tC.want, tC.topology.Destinations(tC.island, tC.islands))
+		})
+	}
+}
+
+func TestMigrationIsDue(t *testing.T) {
+	testCases := []struct {
+		desc       string
+		generation int
+		interval   int
+		want       bool
+	}{
+		{desc: "Should migrate every interval", generation: 10, interval: 5, want: true},
+		{desc: "Should not migrate between intervals", generation: 7, interval: 5, want: false},
+		{desc: "Should not migrate before the first generation", generation: 0, interval: 5, want: false},
+		{desc: "Should never migrate without an interval", generation: 10, interval: 0, want: false},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			assert.Equal(t, tC.want, MigrationIsDue(tC.generation, MigrationOptions{Interval: tC.interval}))
+		})
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	sequencing := [][]byte{[]byte("ABC")}
+	island := func(genome string, fitness ...float64) []*Candidate {
+		population := []*Candidate{}
+		for _, value := range fitness {
+			population = append(population, &Candidate{NewChromosome([]byte(genome), sequencing), value})
+		}
+		return population
+	}
+	genomes := func(population []*Candidate) string {
+		raw := ""
+		for _, candidate := range population {
+			raw += string(candidate.Chromosome.Raw())
+		}
+		return raw
+	}
+
+	t.Run("Should replace the least fit of the next island on a ring", func(t *testing.T) {
+		populations := [][]*Candidate{island("A", 3, 2, 1), island("B", 6, 5, 4), island("C", 9, 8, 7)}
+
+		migrated := Migrate(populations, MigrationOptions{Interval: 1, Migrants: 1})
+
+		assert.Equal(t, "AAC", genomes(migrated[0]))
+		assert.Equal(t, "BBA", genomes(migrated[1]))
+		assert.Equal(t, "CCB", genomes(migrated[2]))
+		assert.Equal(t, []float64{3, 2, 9}, fitnessOf(migrated[0]), "migrants keep their fitness")
+	})
+
+	t.Run("Should receive from every other island when fully connected", func(t *testing.T) {
+		populations := [][]*Candidate{island("A", 3, 2, 1), island("B", 6, 5, 4), island("C", 9, 8, 7)}
+
+		migrated := Migrate(populations, MigrationOptions{Interval: 1, Migrants: 1, Topology: FullyConnectedTopology{}})
+
+		assert.Equal(t, "ABC", genomes(migrated[0]))
+		assert.Equal(t, "BAC", genomes(migrated[1]))
+		assert.Equal(t, "CAB", genomes(migrated[2]))
+	})
+
+	t.Run("Should keep the size of islands smaller than the migrants", func(t *testing.T) {
+		populations := [][]*Candidate{island("A", 1), island("B", 2, 2, 2)}
+
+		migrated := Migrate(populations, MigrationOptions{Interval: 1, Migrants: 3})
+
+		assert.Equal(t, "B", genomes(migrated[0]))
+		assert.Equal(t, "A", genomes(migrated[1])[2:])
+		assert.Len(t, migrated[1], 3)
+	})
+
+	t.Run("Should leave the islands untouched", func(t *testing.T) {
+		populations := [][]*Candidate{island("A", 1, 2), island("B", 3, 4)}
+
+		Migrate(populations, MigrationOptions{Interval: 1, Migrants: 1})
+
+		assert.Equal(t, "AA", genomes(populations[0]))
+		assert.Equal(t, []float64{1, 2}, fitnessOf(populations[0]))
+	})
+}
+
+func TestEvolveIslands(t *testing.T) {
+	bases := []byte("ABC")
+	sequencing := [][]byte{bases, bases}
+	options := GenerationOptions{PopulationSize: 2, MutationRate: 0.1, CutoffRate: 0.5}
+
+	newIsland := func(base byte) *Island {
+		randomizerMock := &RandomizerMock{}
+		randomizerMock.On("PickOne", bases).Return(base)
+		return &Island{[]*Candidate{}, options, randomizerMock}
+	}
+
+	t.Run("Should evolve and evaluate every island with its own random stream", func(t *testing.T) {
+		islands := []*Island{newIsland('A'), newIsland('B'), newIsland('C')}
+		evaluated := map[int]int{}
+		var lock sync.Mutex
+
+		EvolveIslands(islands, sequencing, 0, MigrationOptions{}, func(island int, generation []*Candidate) []*Candidate {
+			lock.Lock()
+			defer lock.Unlock()
+			evaluated[island] = len(generation)
+
+			return withFitness(generation, []float64{float64(island), float64(island)})
+		})
+
+		assert.Equal(t, map[int]int{0: 2, 1: 2, 2: 2}, evaluated)
+		for i, island := range islands {
+			assert.Len(t, island.Population, 2)
+			assert.Equal(t, []byte{bases[i], bases[i]}, island.Population[0].Chromosome.Raw())
+			assert.Equal(t, float64(i), island.Population[0].Fitness)
+		}
+	})
+}
@@ -23,6 +23,11 @@ func (r *RandomizerMock) NumberBetween(min, max int) int {
 	return args.Int(0)
 }
 
+func (r *RandomizerMock) Float() float64 {
+	args := r.Called()
+	return args.Get(0).(float64)
+}
+
 type ChromosomeMock struct {
 	mock.Mock
 }
@@ -41,3 +46,12 @@ func (c *ChromosomeMock) Mutate(randomizer Randomizerish) *Chromosome {
 	args := c.Called(randomizer)
 	return args.Get(0).(*Chromosome)
 }
+
+type SelectorMock struct {
+	mock.Mock
+}
+
+func (s *SelectorMock) Select(candidates []*Candidate, count int, randomizer Randomizerish) []*Candidate {
+	args := s.Called(candidates, count, randomizer)
+	return args.Get(0).([]*Candidate)
+}
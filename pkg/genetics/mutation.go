@@ -0,0 +1,140 @@
+package genetics
+
+import (
+	"bytes"
+
+	"github.com/samber/lo"
+)
+
+// Mutation operators on top of replacing genes with random bases, each off while its rate is zero
+type MutationOptions struct {
+	// Chance of swapping each gene with the next one of its row, when both take the same bases
+	SwapRate float64
+	// Chance of each row being overwritten by a neighbouring row
+	RowCopyRate float64
+	// Chance of each numeric gene moving to the next base up or down
+	CreepRate float64
+	// Chance of each row being randomized whole
+	BlockRate float64
+	// Rows for swap, row copy and block mutation
+	Rows []Block
+	// Genes whose bases are ordered, for creep mutation
+	Numeric []Block
+}
+
+// Public methods
+
+func (chromosome *Chromosome) MutateWith(options MutationOptions, randomizer Randomizerish) *Chromosome {
+	if options.SwapRate > 0 {
+		chromosome.swapMutation(options.Rows, options.SwapRate, randomizer)
+	}
+
+	if options.RowCopyRate > 0 {
+		chromosome.rowCopyMutation(options.Rows, options.RowCopyRate, randomizer)
+	}
+
+	if options.CreepRate > 0 {
+		chromosome.creepMutation(options.Numeric, options.CreepRate, randomizer)
+	}
+
+	if options.BlockRate > 0 {
+		chromosome.blockMutation(options.Rows, options.BlockRate, randomizer)
+	}
+
+	return chromosome
+}
+
+// Private methods
+
+func (chromosome *Chromosome) swapMutation(rows []Block, rate float64, randomizer Randomizerish) {
+	for _, row := range rows {
+		for i := row.Start; i < row.Start+row.Length-1; i++ {
+			if chromosome.isHeld(i) || chromosome.isHeld(i+1) {
+				continue
+			}
+
+			if !bytes.Equal(chromosome.sequencing[i], chromosome.sequencing[i+1]) {
+				continue
+			}
+
+			if randomizer.EventDidHappen(rate) {
+				chromosome.raw[i], chromosome.raw[i+1] = chromosome.raw[i+1], chromosome.raw[i]
+			}
+		}
+	}
+}
+
+func (chromosome *Chromosome) rowCopyMutation(rows []Block, rate float64, randomizer Randomizerish) {
+	for _, row := range rows {
+		if !randomizer.EventDidHappen(rate) {
+			continue
+		}
+
+		below, previous := lo.Find(rows, func(neighbour Block) bool { return isNeighbourRow(neighbour, row, -1) })
+		above, next := lo.Find(rows, func(neighbour Block) bool { return isNeighbourRow(neighbour, row, 1) })
+
+		var source Block
+		switch {
+		case previous && next:
+			source = above
+			if randomizer.EventDidHappen(0.5) {
+				source = below
+			}
+		case previous:
+			source = below
+		case next:
+			source = above
+		default:
+			continue
+		}
+
+		for gene := 0; gene < row.Length; gene++ {
+			if !chromosome.isHeld(row.Start + gene) {
+				chromosome.raw[row.Start+gene] = chromosome.raw[source.Start+gene]
+			}
+		}
+	}
+}
+
+func (chromosome *Chromosome) creepMutation(numeric []Block, rate float64, randomizer Randomizerish) {
+	for _, block := range numeric {
+		for gene := block.Start; gene < block.Start+block.Length; gene++ {
+			if chromosome.isHeld(gene) || !randomizer.EventDidHappen(rate) {
+				continue
+			}
+
+			bases := chromosome.sequencing[gene]
+			index := bytes.IndexByte(bases, chromosome.raw[gene])
+			if randomizer.EventDidHappen(0.5) {
+				index++
+			} else {
+				index--
+			}
+
+			if index >= 0 && index < len(bases) {
+				chromosome.raw[gene] = bases[index]
+			}
+		}
+	}
+}
+
+func (chromosome *Chromosome) blockMutation(rows []Block, rate float64, randomizer Randomizerish) {
+	for _, row := range rows {
+		if !randomizer.EventDidHappen(rate) {
+			continue
+		}
+
+		for gene := row.Start; gene < row.Start+row.Length; gene++ {
+			if !chromosome.isHeld(gene) {
+				chromosome.raw[gene] = randomizer.PickOne(chromosome.sequencing[gene])
+			}
+		}
+	}
+}
+
+// Helper methods
+
+// Whether the neighbour is the row of the same group standing for the total step away
+func isNeighbourRow(neighbour Block, row Block, step int) bool {
+	return row.Total > 0 && neighbour.Group == row.Group && neighbour.Total == row.Total+step && neighbour.Length == row.Length
+}
@@ -0,0 +1,158 @@
+package genetics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMutationOperators(t *testing.T) {
+	bases := []byte("ABC")
+	digits := []byte("0123")
+	sequencing := [][]byte{bases, bases, bases, bases, bases, bases}
+	rows := []Block{{"hard", 0, 2, 12}, {"hard", 2, 2, 13}, {"hard", 4, 2, 14}}
+
+	t.Run("Should leave the genome alone when every operator is off", func(t *testing.T) {
+		randomizerMock := &RandomizerMock{}
+
+		got := NewChromosome([]byte("AABBCC"), sequencing).MutateWith(MutationOptions{Rows: rows}, randomizerMock)
+
+		assert.Equal(t, []byte("AABBCC"), got.Raw())
+		randomizerMock.AssertExpectations(t)
+	})
+
+	t.Run("Should swap adjacent genes", func(t *testing.T) {
+		randomizerMock := &RandomizerMock{}
+		randomizerMock.On("EventDidHappen", 0.1).Return(true).Once()
+		randomizerMock.On("EventDidHappen", 0.1).Return(false)
+
+		got := NewChromosome([]byte("ABCABC"), sequencing).MutateWith(MutationOptions{SwapRate: 0.1, Rows: rows}, randomizerMock)
+
+		assert.Equal(t, []byte("BACABC"), got.Raw())
+	})
+
+	t.Run("Should only swap genes of the same row", func(t *testing.T) {
+		randomizerMock := &RandomizerMock{}
+		randomizerMock.On("EventDidHappen", 0.1).Return(true)
+
+		got := NewChromosome([]byte("ABCABC"), sequencing).MutateWith(MutationOptions{SwapRate: 0.1, Rows: rows}, randomizerMock)
+
+		assert.Equal(t, []byte("BAACCB"), got.Raw())
+		randomizerMock.AssertNumberOfCalls(t, "EventDidHappen", 3)
+	})
+
+	t.Run("Should only swap genes that take the same bases", func(t *testing.T) {
+		mixed := [][]byte{bases, digits, digits}
+		randomizerMock := &RandomizerMock{}
+		randomizerMock.On("EventDidHappen", 0.1).Return(true)
+
+		got := NewChromosome([]byte("A12"), mixed).MutateWith(MutationOptions{SwapRate: 0.1, Rows: []Block{{"bet", 0, 3, 0}}}, randomizerMock)
+
+		assert.Equal(t, []byte("A21"), got.Raw())
+		randomizerMock.AssertNumberOfCalls(t, "EventDidHappen", 1)
+	})
+
+	t.Run("Should copy a row from a neighbouring one", func(t *testing.T) {
+		randomizerMock := &RandomizerMock{}
+		randomizerMock.On("EventDidHappen", 0.2).Return(false).Once()
+		randomizerMock.On("EventDidHappen", 0.2).Return(true).Once()
+		randomizerMock.On("EventDidHappen", 0.2).Return(false).Once()
+		// the previous row, not the next
+		randomizerMock.On("EventDidHappen", 0.5).Return(true).Once()
+
+		got := NewChromosome([]byte("AABBCC"), sequencing).MutateWith(MutationOptions{RowCopyRate: 0.2, Rows: rows}, randomizerMock)
+
+		assert.Equal(t, []byte("AAAACC"), got.Raw())
+		randomizerMock.AssertExpectations(t)
+	})
+
+	testCases := []struct {
+		desc string
+		rows []Block
+	}{
+		{desc: "Should not copy rows across groups", rows: []Block{{"hard", 0, 2, 12}, {"soft", 2, 2, 13}}},
+		{desc: "Should not copy rows side by side but totals apart", rows: []Block{{"3 cards", 0, 2, 16}, {"4+ cards", 2, 2, 12}}},
+		{desc: "Should not copy rows without a total", rows: []Block{{"pair", 0, 2, 0}, {"pair", 2, 2, 0}}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.desc, func(t *testing.T) {
+			randomizerMock := &RandomizerMock{}
+			randomizerMock.On("EventDidHappen", 0.2).Return(true)
+
+			got := NewChromosome([]byte("AABBCC"), sequencing).MutateWith(MutationOptions{RowCopyRate: 0.2, Rows: testCase.rows}, randomizerMock)
+
+			assert.Equal(t, []byte("AABBCC"), got.Raw())
+		})
+	}
+
+	t.Run("Should copy a row from the neighbouring total wherever it is listed", func(t *testing.T) {
+		shuffled := []Block{{"hard", 0, 2, 14}, {"hard", 2, 2, 12}, {"hard", 4, 2, 13}}
+		randomizerMock := &RandomizerMock{}
+		randomizerMock.On("EventDidHappen", 0.2).Return(true).Once()
+		randomizerMock.On("EventDidHappen", 0.2).Return(false)
+
+		got := NewChromosome([]byte("AABBCC"), sequencing).MutateWith(MutationOptions{RowCopyRate: 0.2, Rows: shuffled}, randomizerMock)
+
+		assert.Equal(t, []byte("CCBBCC"), got.Raw())
+	})
+
+	t.Run("Should leave the rate gene of a self-adaptive chromosome alone", func(t *testing.T) {
+		adaptive := [][]byte{bases, bases, RateGeneBases}
+		randomizerMock := &RandomizerMock{}
+		randomizerMock.On("EventDidHappen", 0.4).Return(true)
+		randomizerMock.On("PickOne", bases).Return(byte('C'))
+
+		options := MutationOptions{
+			SwapRate:  0.4,
+			CreepRate: 0.4,
+			BlockRate: 0.4,
+			Rows:      []Block{{"hard", 0, 2, 12}, {"mutation-rate", 2, 1, 0}},
+			Numeric:   []Block{{"mutation-rate", 2, 1, 0}},
+		}
+		got := NewChromosome([]byte("AB-"), adaptive).WithMutationRate(2, rateOfGene('4')).MutateWith(options, randomizerMock)
+
+		assert.Equal(t, []byte("CC4"), got.Raw())
+		randomizerMock.AssertNotCalled(t, "PickOne", RateGeneBases)
+	})
+
+	t.Run("Should creep numeric genes to the next base up or down", func(t *testing.T) {
+		numeric := [][]byte{digits, digits, digits, digits}
+		randomizerMock := &RandomizerMock{}
+		randomizerMock.On("EventDidHappen", 0.3).Return(true).Once()
+		randomizerMock.On("EventDidHappen", 0.3).Return(false).Once()
+		randomizerMock.On("EventDidHappen", 0.3).Return(true).Times(2)
+		// up, down, then down past the lowest base
+		randomizerMock.On("EventDidHappen", 0.5).Return(true).Once()
+		randomizerMock.On("EventDidHappen", 0.5).Return(false).Times(2)
+
+		options := MutationOptions{CreepRate: 0.3, Numeric: []Block{{"bet", 0, 4, 0}}}
+		got := NewChromosome([]byte("1110"), numeric).MutateWith(options, randomizerMock)
+
+		assert.Equal(t, []byte("2100"), got.Raw())
+		randomizerMock.AssertExpectations(t)
+	})
+
+	t.Run("Should randomize whole rows", func(t *testing.T) {
+		randomizerMock := &RandomizerMock{}
+		randomizerMock.On("EventDidHappen", 0.1).Return(false).Once()
+		randomizerMock.On("EventDidHappen", 0.1).Return(true).Once()
+		randomizerMock.On("EventDidHappen", 0.1).Return(false).Once()
+		randomizerMock.On("PickOne", bases).Return(byte('C'))
+
+		got := NewChromosome([]byte("AABBAA"), sequencing).MutateWith(MutationOptions{BlockRate: 0.1, Rows: rows}, randomizerMock)
+
+		assert.Equal(t, []byte("AACCAA"), got.Raw())
+	})
+
+	t.Run("Should never touch frozen genes", func(t *testing.T) {
+		randomizerMock := &RandomizerMock{}
+		randomizerMock.On("EventDidHappen", 0.1).Return(true)
+		randomizerMock.On("PickOne", bases).Return(byte('C'))
+
+		mask := FreezeMask{'A', Unfrozen, Unfrozen, Unfrozen, Unfrozen, Unfrozen}
+		got := NewChromosome([]byte("AABBAA"), sequencing).Freeze(mask).MutateWith(MutationOptions{BlockRate: 0.1, Rows: rows}, randomizerMock)
+
+		assert.Equal(t, []byte("ACCCCC"), got.Raw())
+	})
+}
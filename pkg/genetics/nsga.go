@@ -0,0 +1,175 @@
+package genetics
+
+import (
+	"math"
+	"sort"
+
+	"github.com/samber/lo"
+)
+
+// A candidate scored on several objectives at once, every one of them maximized
+type ObjectiveCandidate struct {
+	Chromosome *Chromosome
+	Objectives []float64
+}
+
+// Public methods
+
+// At least as good on every objective and better on one
+func Dominates(a []float64, b []float64) bool {
+	better := false
+	for i := range a {
+		if a[i] < b[i] {
+			return false
+		}
+		if a[i] > b[i] {
+			better = true
+		}
+	}
+
+	return better
+}
+
+// Indexes of the candidates in fronts, the first dominated by none, each next one only by those before it
+func NonDominatedSort(candidates []*ObjectiveCandidate) [][]int {
+	dominatedBy := make([]int, len(candidates))
+	dominating := make([][]int, len(candidates))
+	front := []int{}
+
+	for i, a := range candidates {
+		for j, b := range candidates {
+			if Dominates(a.Objectives, b.Objectives) {
+				dominating[i] = append(dominating[i], j)
+			} else if Dominates(b.Objectives, a.Objectives) {
+				dominatedBy[i]++
+			}
+		}
+
+		if dominatedBy[i] == 0 {
+			front = append(front, i)
+		}
+	}
+
+	fronts := [][]int{}
+	for len(front) > 0 {
+		fronts = append(fronts, front)
+
+		next := []int{}
+		for _, i := range front {
+			for _, j := range dominating[i] {
+				dominatedBy[j]--
+				if dominatedBy[j] == 0 {
+					next = append(next, j)
+				}
+			}
+		}
+		front = next
+	}
+
+	return fronts
+}
+
+// How far each candidate of a front is from its neighbours, over every objective;
+// the candidates at either end of an objective are infinitely far, to keep the front wide
+func CrowdingDistance(candidates []*ObjectiveCandidate, front []int) []float64 {
+	distance := make([]float64, len(front))
+	if len(front) == 0 {
+		return distance
+	}
+
+	for objective := range candidates[front[0]].Objectives {
+		value := func(position int) float64 { return candidates[front[position]].Objectives[objective] }
+		order := lo.Range(len(front))
+		sort.SliceStable(order, func(i, j int) bool { return value(order[i]) < value(order[j]) })
+
+		lowest := value(order[0])
+		highest := value(order[len(order)-1])
+		distance[order[0]] = math.Inf(1)
+		distance[order[len(order)-1]] = math.Inf(1)
+		if highest == lowest {
+			continue
+		}
+
+		for i := 1; i < len(order)-1; i++ {
+			distance[order[i]] += (value(order[i+1]) - value(order[i-1])) / (highest - lowest)
+		}
+	}
+
+	return distance
+}
+
+// The candidates no other one dominates
+func ParetoFront(candidates []*ObjectiveCandidate) []*ObjectiveCandidate {
+	fronts := NonDominatedSort(candidates)
+	if len(fronts) == 0 {
+		return []*ObjectiveCandidate{}
+	}
+
+	return lo.Map(fronts[0], func(i int, _ int) *ObjectiveCandidate { return candidates[i] })
+}
+
+// The best candidates front by front, the last front that doesn't fit whole cut to its least crowded
+func NSGA2Survivors(candidates []*ObjectiveCandidate, size int) []*ObjectiveCandidate {
+	survivors := []*ObjectiveCandidate{}
+
+	for _, front := range NonDominatedSort(candidates) {
+		if len(survivors) >= size {
+			break
+		}
+
+		for _, i := range byCrowding(candidates, front) {
+			if len(survivors) < size {
+				survivors = append(survivors, candidates[i])
+			}
+		}
+	}
+
+	return survivors
+}
+
+// Fitness ordering candidates by front, then by crowding distance, for any selector to apply the crowded comparison
+func CrowdedFitness(candidates []*ObjectiveCandidate) []*Candidate {
+	fitness := make([]float64, len(candidates))
+
+	fronts := NonDominatedSort(candidates)
+	for rank, front := range fronts {
+		for position, distance := range CrowdingDistance(candidates, front) {
+			// below 0.5, so no crowding lifts a candidate to the front above
+			crowding := 0.5
+			if !math.IsInf(distance, 1) {
+				crowding = 0.5 * distance / (1 + distance)
+			}
+
+			fitness[front[position]] = float64(len(fronts)-rank) + crowding
+		}
+	}
+
+	return lo.Map(candidates, func(candidate *ObjectiveCandidate, i int) *Candidate {
+		return &Candidate{candidate.Chromosome, fitness[i]}
+	})
+}
+
+// Offspring of the survivors of a multi-objective generation, picked by crowded comparison; a tournament when no selector is set.
+// The survivors are kept by NSGA2Survivors, so none are carried over or cloned here, and fitness isn't shared.
+func NewMultiObjectiveGeneration(survivors []*ObjectiveCandidate, sequencing [][]byte, options GenerationOptions, randomizer Randomizerish) []*Candidate {
+	if options.Selector == nil {
+		options.Selector = TournamentSelector{}
+	}
+	options.CloneRate = 0
+	options.Elitism = 0
+	options.Scaling = MaxScaling{}
+	options.SharingRadius = 0
+
+	return NewGenerationFromPrevious(CrowdedFitness(survivors), sequencing, options, randomizer)
+}
+
+// Helper methods
+
+// The indexes of a front, least crowded first
+func byCrowding(candidates []*ObjectiveCandidate, front []int) []int {
+	distance := CrowdingDistance(candidates, front)
+	order := lo.Range(len(front))
+	sort.SliceStable(order, func(i, j int) bool { return distance[order[i]] > distance[order[j]] })
+
+	return lo.Map(order, func(position int, _ int) int { return front[position] })
+}
@@ -0,0 +1,150 @@
+package genetics
+
+import (
+	"math"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Helpers
+
+func objectiveCandidates(objectives ...[]float64) []*ObjectiveCandidate {
+	return lo.Map(objectives, func(values []float64, _ int) *ObjectiveCandidate {
+		return &ObjectiveCandidate{Objectives: values}
+	})
+}
+
+// Tests
+
+func TestDominates(t *testing.T) {
+	testCases := []struct {
+		desc string
+		a    []float64
+		b    []float64
+		want bool
+	}{
+		{desc: "Should dominate when better on every objective", a: []float64{2, 2}, b: []float64{1, 1}, want: true},
+		{desc: "Should dominate when better on one and as good on the rest", a: []float64{2, 1}, b: []float64{1, 1}, want: true},
+		{desc: "Should not dominate an equal", a: []float64{1, 1}, b: []float64{1, 1}, want: false},
+		{desc: "Should not dominate when worse on any objective", a: []float64{3, 0}, b: []float64{1, 1}, want: false},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			assert.Equal(t, tC.want, Dominates(tC.a, tC.b))
+		})
+	}
+}
+
+func TestNonDominatedSorting(t *testing.T) {
+	candidates := objectiveCandidates(
+		[]float64{3, 1},
+		[]float64{1, 3},
+		[]float64{2, 2},
+		[]float64{1, 1},
+		[]float64{0, 0},
+	)
+
+	t.Run("Should sort candidates into fronts", func(t *testing.T) {
+		assert.Equal(t, [][]int{{0, 1, 2}, {3}, {4}}, NonDominatedSort(candidates))
+	})
+
+	t.Run("Should find the Pareto front", func(t *testing.T) {
+		assert.Equal(t, candidates[:3], ParetoFront(candidates))
+		assert.Empty(t, ParetoFront([]*ObjectiveCandidate{}))
+	})
+
+	t.Run("Should measure crowding, the ends of the front infinitely far", func(t *testing.T) {
+		distance := CrowdingDistance(candidates, []int{0, 1, 2})
+
+		assert.Equal(t, []float64{math.Inf(1), math.Inf(1), 2}, distance)
+	})
+
+	t.Run("Should not count objectives every candidate shares", func(t *testing.T) {
+		flat := objectiveCandidates([]float64{1, 5}, []float64{2, 5}, []float64{3, 5})
+
+		assert.Equal(t, []float64{math.Inf(1), 1, math.Inf(1)}, CrowdingDistance(flat, []int{0, 1, 2}))
+	})
+
+	t.Run("Should keep survivors front by front", func(t *testing.T) {
+		survivors := NSGA2Survivors(candidates, 4)
+
+		assert.ElementsMatch(t, candidates[:4], survivors)
+	})
+
+	t.Run("Should cut the last front to its least crowded", func(t *testing.T) {
+		survivors := NSGA2Survivors(candidates, 2)
+
+		assert.ElementsMatch(t, candidates[:2], survivors)
+	})
+
+	t.Run("Should order fitness by front, then crowding", func(t *testing.T) {
+		fitness := fitnessOf(CrowdedFitness(candidates))
+
+		assert.Equal(t, fitness[0], fitness[1])
+		assert.Greater(t, fitness[1], fitness[2])
+		assert.Greater(t, fitness[2], fitness[3])
+		assert.Greater(t, fitness[3], fitness[4])
+		assert.Greater(t, fitness[4], 0.0)
+	})
+}
+
+func TestNewMultiObjectiveGeneration(t *testing.T) {
+	bases := []byte("ABC")
+	sequencing := [][]byte{bases, bases, bases}
+	options := GenerationOptions{PopulationSize: 2, MutationRate: 0.1}
+
+	t.Run("Should start from random candidates", func(t *testing.T) {
+		randomizerMock := &RandomizerMock{}
+		randomizerMock.On("PickOne", bases).Return(bases[0])
+
+		got := NewMultiObjectiveGeneration([]*ObjectiveCandidate{}, sequencing, options, randomizerMock)
+
+		assert.Len(t, got, 2)
+		assert.Equal(t, []byte("AAA"), got[0].Chromosome.Raw())
+	})
+
+	t.Run("Should breed the survivors without carrying them over", func(t *testing.T) {
+		survivors := []*ObjectiveCandidate{
+			{NewChromosome([]byte("AAA"), sequencing), []float64{1, 1}},
+			{NewChromosome([]byte("BBB"), sequencing), []float64{2, 2}},
+		}
+		selector := &SelectorMock{}
+		selector.On("Select", mock.Anything, 0, mock.Anything).Return([]*Candidate{})
+		selector.On("Select", mock.Anything, 4, mock.Anything).Return([]*Candidate{
+			{survivors[1].Chromosome, 2.5}, {survivors[1].Chromosome, 2.5},
+			{survivors[1].Chromosome, 2.5}, {survivors[1].Chromosome, 2.5},
+		})
+		randomizerMock := &RandomizerMock{}
+		randomizerMock.On("EventDidHappen", mock.Anything).Return(false)
+
+		got := NewMultiObjectiveGeneration(survivors, sequencing, GenerationOptions{PopulationSize: 2, Selector: selector}, randomizerMock)
+
+		assert.Len(t, got, 2)
+		assert.Equal(t, []byte("BBB"), got[0].Chromosome.Raw())
+		assert.NotSame(t, survivors[1].Chromosome, got[0].Chromosome)
+	})
+
+	t.Run("Should not clone survivors or share their fitness", func(t *testing.T) {
+		survivors := []*ObjectiveCandidate{
+			{NewChromosome([]byte("AAA"), sequencing), []float64{1, 2}},
+			{NewChromosome([]byte("BBB"), sequencing), []float64{2, 1}},
+			{NewChromosome([]byte("CCC"), sequencing), []float64{0, 0}},
+		}
+		randomizerMock := &RandomizerMock{}
+		randomizerMock.On("NumberBetween", mock.Anything, mock.Anything).Return(0)
+		randomizerMock.On("EventDidHappen", mock.Anything).Return(false)
+
+		got := NewMultiObjectiveGeneration(survivors, sequencing, GenerationOptions{PopulationSize: 6, CloneRate: 0.5, SharingRadius: 1}, randomizerMock)
+
+		assert.Len(t, got, 6)
+		for _, offspring := range got {
+			for _, survivor := range survivors {
+				assert.NotSame(t, survivor.Chromosome, offspring.Chromosome)
+			}
+		}
+	})
+}
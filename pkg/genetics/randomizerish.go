@@ -4,4 +4,6 @@ type Randomizerish interface {
 	EventDidHappen(probability float64) bool
 	PickOne(options []byte) byte
 	NumberBetween(min, max int) int
+	// A number in [0, 1)
+	Float() float64
 }
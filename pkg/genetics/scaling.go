@@ -0,0 +1,175 @@
+package genetics
+
+import (
+	"math"
+	"sort"
+
+	"github.com/samber/lo"
+)
+
+type Scalerish interface {
+	// Fitness from 0 to 1, the fittest candidate at 1; NaN and infinite fitness count as the worst
+	Scale(candidates []*Candidate) []*Candidate
+}
+
+// Fitness over the maximum, zero and negative fitness at 0
+type MaxScaling struct{}
+
+// Fitness from the minimum at 0 to the maximum at 1
+type MinMaxScaling struct{}
+
+// Fitness above the mean minus C standard deviations, 2 when unset, so outliers don't take over
+type SigmaScaling struct {
+	C float64
+}
+
+// Fitness by rank from the worst at 0 to the best at 1, ties sharing their rank but the fittest ones all at 1
+type RankScaling struct{}
+
+// Min-max fitness through exp((f - 1) / Temperature), 1 when unset; a lower temperature favors the fittest more
+type BoltzmannScaling struct {
+	Temperature float64
+}
+
+// Public methods
+
+func (scaling MaxScaling) Scale(candidates []*Candidate) []*Candidate {
+	return NormalizeFitnessList(candidates)
+}
+
+func (scaling MinMaxScaling) Scale(candidates []*Candidate) []*Candidate {
+	fitness := finiteFitness(candidates)
+	min, max := fitnessRange(fitness)
+
+	return withFitness(candidates, lo.Map(fitness, func(value float64, _ int) float64 {
+		if math.IsNaN(value) {
+			return 0
+		}
+		if max == min {
+			return 1
+		}
+
+		return (value - min) / (max - min)
+	}))
+}
+
+func (scaling SigmaScaling) Scale(candidates []*Candidate) []*Candidate {
+	c := lo.Ternary(scaling.C > 0, scaling.C, 2.0)
+	fitness := finiteFitness(candidates)
+	finite := lo.Filter(fitness, func(value float64, _ int) bool { return !math.IsNaN(value) })
+
+	mean, deviation := 0.0, 0.0
+	if len(finite) > 0 {
+		mean = lo.Sum(finite) / float64(len(finite))
+		for _, value := range finite {
+			deviation += (value - mean) * (value - mean)
+		}
+		deviation = math.Sqrt(deviation / float64(len(finite)))
+	}
+
+	scaled := lo.Map(fitness, func(value float64, _ int) float64 {
+		if math.IsNaN(value) {
+			return 0
+		}
+		if deviation == 0 {
+			return 1
+		}
+
+		return math.Max(1+(value-mean)/(c*deviation), 0)
+	})
+
+	_, max := fitnessRange(scaled)
+	return withFitness(candidates, lo.Map(scaled, func(value float64, _ int) float64 {
+		return lo.Ternary(max > 0, value/max, 0)
+	}))
+}
+
+func (scaling RankScaling) Scale(candidates []*Candidate) []*Candidate {
+	fitness := finiteFitness(candidates)
+	order := make([]int, len(fitness))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return isLessFit(fitness[order[i]], fitness[order[j]])
+	})
+
+	scaled := make([]float64, len(fitness))
+	for start := 0; start < len(order); {
+		end := start
+		for end+1 < len(order) && sameFitness(fitness[order[end+1]], fitness[order[start]]) {
+			end++
+		}
+
+		// the fittest stay at 1 even when tied, as in every other scaling
+		rank := 1.0
+		if end < len(order)-1 {
+			rank = float64(start+end) / 2 / float64(len(order)-1)
+		}
+		for i := start; i <= end; i++ {
+			scaled[order[i]] = rank
+		}
+		start = end + 1
+	}
+
+	return withFitness(candidates, scaled)
+}
+
+func (scaling BoltzmannScaling) Scale(candidates []*Candidate) []*Candidate {
+	temperature := lo.Ternary(scaling.Temperature > 0, scaling.Temperature, 1.0)
+	normalized := MinMaxScaling{}.Scale(candidates)
+
+	return withFitness(candidates, lo.Map(normalized, func(candidate *Candidate, _ int) float64 {
+		// fitness too far apart to normalize comes out NaN, it counts as the worst
+		fitness := lo.Ternary(math.IsNaN(candidate.Fitness), 0, candidate.Fitness)
+
+		return math.Exp((fitness - 1) / temperature)
+	}))
+}
+
+// Helper methods
+
+// Fitness with infinities turned into NaN, so both count as the worst
+func finiteFitness(candidates []*Candidate) []float64 {
+	return lo.Map(candidates, func(candidate *Candidate, _ int) float64 {
+		if math.IsInf(candidate.Fitness, 0) {
+			return math.NaN()
+		}
+
+		return candidate.Fitness
+	})
+}
+
+// Lowest and highest fitness, NaN left out; both 0 when there is none
+func fitnessRange(fitness []float64) (float64, float64) {
+	finite := lo.Filter(fitness, func(value float64, _ int) bool { return !math.IsNaN(value) })
+	if len(finite) == 0 {
+		return 0, 0
+	}
+
+	return lo.Min(finite), lo.Max(finite)
+}
+
+func isLessFit(a float64, b float64) bool {
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return math.IsNaN(a) && !math.IsNaN(b)
+	}
+
+	return a < b
+}
+
+func sameFitness(a float64, b float64) bool {
+	return a == b || (math.IsNaN(a) && math.IsNaN(b))
+}
+
+func withFitness(candidates []*Candidate, fitness []float64) []*Candidate {
+	return lo.Map(candidates, func(candidate *Candidate, i int) *Candidate {
+		return &Candidate{candidate.Chromosome, fitness[i]}
+	})
+}
+
+func fitnessOf(candidates []*Candidate) []float64 {
+	return lo.Map(candidates, func(candidate *Candidate, _ int) float64 {
+		return candidate.Fitness
+	})
+}
@@ -0,0 +1,95 @@
+package genetics
+
+import (
+	"math"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+// Helpers
+
+func candidatesWithFitness(fitness ...float64) []*Candidate {
+	return lo.Map(fitness, func(value float64, _ int) *Candidate {
+		return &Candidate{Fitness: value}
+	})
+}
+
+// Tests
+
+func TestFitnessScaling(t *testing.T) {
+	nan := math.NaN()
+	inf := math.Inf(1)
+
+	testCases := []struct {
+		desc    string
+		scaling Scalerish
+		fitness []float64
+		want    []float64
+	}{
+		{desc: "Max: Should divide by the maximum", scaling: MaxScaling{}, fitness: []float64{0, 25, 50}, want: []float64{0, 0.5, 1}},
+		{desc: "Max: Should put negative fitness at 0", scaling: MaxScaling{}, fitness: []float64{-10, 0, 10}, want: []float64{0, 0, 1}},
+		{desc: "Max: Should put everyone at 0 when nobody is fit", scaling: MaxScaling{}, fitness: []float64{-5, -1, 0}, want: []float64{0, 0, 0}},
+		{desc: "Max: Should put NaN and infinite fitness at 0", scaling: MaxScaling{}, fitness: []float64{nan, inf, 10}, want: []float64{0, 0, 1}},
+
+		{desc: "Min-max: Should spread fitness from 0 to 1", scaling: MinMaxScaling{}, fitness: []float64{-10, 0, 10}, want: []float64{0, 0.5, 1}},
+		{desc: "Min-max: Should put equal fitness at 1", scaling: MinMaxScaling{}, fitness: []float64{3, 3}, want: []float64{1, 1}},
+		{desc: "Min-max: Should put NaN fitness at 0", scaling: MinMaxScaling{}, fitness: []float64{nan, 5, 10}, want: []float64{0, 0, 1}},
+		{desc: "Min-max: Should put everyone at 0 when all fitness is NaN", scaling: MinMaxScaling{}, fitness: []float64{nan, inf}, want: []float64{0, 0}},
+
+		{desc: "Sigma: Should cut fitness below the mean minus C deviations", scaling: SigmaScaling{C: 1}, fitness: []float64{0, 10}, want: []float64{0, 1}},
+		{desc: "Sigma: Should handle negative fitness", scaling: SigmaScaling{}, fitness: []float64{-10, -20, -30}, want: []float64{1, 0.6203, 0.2404}},
+		{desc: "Sigma: Should put equal fitness at 1", scaling: SigmaScaling{}, fitness: []float64{-4, -4}, want: []float64{1, 1}},
+		{desc: "Sigma: Should put NaN fitness at 0", scaling: SigmaScaling{C: 1}, fitness: []float64{nan, 0, 10}, want: []float64{0, 0, 1}},
+
+		{desc: "Rank: Should scale by rank", scaling: RankScaling{}, fitness: []float64{30, -10, 20}, want: []float64{1, 0, 0.5}},
+		{desc: "Rank: Should share ranks between ties", scaling: RankScaling{}, fitness: []float64{10, 10, 20, 5}, want: []float64{0.5, 0.5, 1, 0}},
+		{desc: "Rank: Should rank NaN fitness last", scaling: RankScaling{}, fitness: []float64{nan, -1}, want: []float64{0, 1}},
+		{desc: "Rank: Should put a lone candidate at 1", scaling: RankScaling{}, fitness: []float64{7}, want: []float64{1}},
+		{desc: "Rank: Should put equal fitness at 1", scaling: RankScaling{}, fitness: []float64{4, 4, 4}, want: []float64{1, 1, 1}},
+		{desc: "Rank: Should put the fittest at 1 when they tie", scaling: RankScaling{}, fitness: []float64{20, 5, 20}, want: []float64{1, 0, 1}},
+
+		{desc: "Boltzmann: Should favor the fittest with the temperature", scaling: BoltzmannScaling{Temperature: 0.5}, fitness: []float64{0, 10}, want: []float64{0.1353, 1}},
+		{desc: "Boltzmann: Should put equal fitness at 1", scaling: BoltzmannScaling{}, fitness: []float64{-2, -2}, want: []float64{1, 1}},
+		{desc: "Boltzmann: Should put NaN fitness at the bottom", scaling: BoltzmannScaling{}, fitness: []float64{nan, 0, 10}, want: []float64{0.3679, 0.3679, 1}},
+		{desc: "Boltzmann: Should put fitness too far apart to normalize at the bottom", scaling: BoltzmannScaling{}, fitness: []float64{-math.MaxFloat64, math.MaxFloat64}, want: []float64{0.3679, 0.3679}},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			got := fitnessOf(tC.scaling.Scale(candidatesWithFitness(tC.fitness...)))
+
+			assert.InDeltaSlice(t, tC.want, got, 1e-4)
+		})
+	}
+
+	scalings := []Scalerish{MaxScaling{}, MinMaxScaling{}, SigmaScaling{}, RankScaling{}, BoltzmannScaling{}}
+
+	t.Run("Should scale no candidates to none", func(t *testing.T) {
+		for _, scaling := range scalings {
+			assert.Empty(t, scaling.Scale([]*Candidate{}))
+		}
+	})
+
+	t.Run("Should never return NaN or fitness outside 0 and 1", func(t *testing.T) {
+		for _, scaling := range scalings {
+			for _, fitness := range fitnessOf(scaling.Scale(candidatesWithFitness(nan, -inf, -3, 0, 0, 2e9))) {
+				assert.False(t, math.IsNaN(fitness))
+				assert.GreaterOrEqual(t, fitness, 0.0)
+				assert.LessOrEqual(t, fitness, 1.0)
+			}
+		}
+	})
+
+	t.Run("Should keep the chromosomes and leave the input alone", func(t *testing.T) {
+		input := []*Candidate{{NewChromosome([]byte("A"), nil), 5}, {NewChromosome([]byte("B"), nil), 10}}
+
+		for _, scaling := range scalings {
+			got := scaling.Scale(input)
+
+			assert.Same(t, input[0].Chromosome, got[0].Chromosome)
+			assert.Equal(t, 5.0, input[0].Fitness)
+		}
+	})
+}
@@ -0,0 +1,125 @@
+package genetics
+
+import (
+	"bytes"
+	"math"
+
+	"github.com/samber/lo"
+)
+
+type MutationScheduleish interface {
+	// The mutation rate of a generation, counting from 0, knowing the diversity of the previous one from 0 to 1
+	Rate(generation int, diversity float64) float64
+}
+
+type ConstantSchedule struct {
+	MutationRate float64
+}
+
+// From Start to End over a number of generations, End from then on
+type LinearDecaySchedule struct {
+	Start       float64
+	End         float64
+	Generations int
+}
+
+// Start multiplied by Decay every generation, never below Floor
+type ExponentialDecaySchedule struct {
+	Start float64
+	Decay float64
+	Floor float64
+}
+
+// The rate of another schedule, multiplied by Factor while diversity is below Threshold
+type DiversityBoostSchedule struct {
+	Base      MutationScheduleish
+	Threshold float64
+	Factor    float64
+}
+
+// Self-adaptive rates start at MinSelfAdaptiveRate and move a step up or down every generation
+const (
+	MinSelfAdaptiveRate  = 0.001
+	selfAdaptiveRateStep = 1.5
+)
+
+// A self-adaptive chromosome carries its rate in its rate gene, as one of these levels or - when it carries none.
+// Level n stands for MinSelfAdaptiveRate times selfAdaptiveRateStep to the n, so the highest is about 0.44
+var RateGeneBases = []byte("-0123456789ABCDEF")
+
+// Public methods
+
+func (schedule ConstantSchedule) Rate(generation int, diversity float64) float64 {
+	return schedule.MutationRate
+}
+
+func (schedule LinearDecaySchedule) Rate(generation int, diversity float64) float64 {
+	if schedule.Generations <= 0 {
+		return schedule.End
+	}
+
+	progress := math.Min(float64(generation)/float64(schedule.Generations), 1.0)
+	return schedule.Start + (schedule.End-schedule.Start)*progress
+}
+
+func (schedule ExponentialDecaySchedule) Rate(generation int, diversity float64) float64 {
+	return math.Max(schedule.Start*math.Pow(schedule.Decay, float64(generation)), schedule.Floor)
+}
+
+func (schedule DiversityBoostSchedule) Rate(generation int, diversity float64) float64 {
+	rate := schedule.Base.Rate(generation, diversity)
+	if diversity < schedule.Threshold {
+		rate = math.Min(rate*schedule.Factor, 1.0)
+	}
+
+	return rate
+}
+
+// The mutation rate a generation is built with, from the schedule if there is one
+func EffectiveMutationRate(previous []*Candidate, options GenerationOptions) float64 {
+	if options.Schedule == nil {
+		return options.MutationRate
+	}
+
+	return options.Schedule.Rate(options.Generation, GenotypeDiversity(previous))
+}
+
+// Average of the rates candidates carry, 0 when none does
+func AverageMutationRate(candidates []*Candidate) float64 {
+	sum, count := 0.0, 0
+	for _, candidate := range candidates {
+		if rate := candidate.Chromosome.MutationRate(); rate > 0 {
+			sum += rate
+			count++
+		}
+	}
+
+	if count == 0 {
+		return 0
+	}
+
+	return sum / float64(count)
+}
+
+// Helper methods
+
+// The rate a level of the rate gene stands for, 0 for none
+func rateOfGene(gene byte) float64 {
+	level := bytes.IndexByte(RateGeneBases, gene) - 1
+	if level < 0 {
+		return 0
+	}
+
+	return MinSelfAdaptiveRate * math.Pow(selfAdaptiveRateStep, float64(level))
+}
+
+// The level of the rate gene closest to a rate, rates out of range at the lowest or highest level
+func geneOfRate(rate float64) byte {
+	levels := len(RateGeneBases) - 1
+	if rate <= MinSelfAdaptiveRate {
+		return RateGeneBases[1]
+	}
+
+	level := int(math.Round(math.Log(rate/MinSelfAdaptiveRate) / math.Log(selfAdaptiveRateStep)))
+	return RateGeneBases[1+lo.Clamp(level, 0, levels-1)]
+}
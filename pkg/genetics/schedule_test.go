@@ -0,0 +1,144 @@
+package genetics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMutationSchedules(t *testing.T) {
+	testCases := []struct {
+		desc       string
+		schedule   MutationScheduleish
+		generation int
+		diversity  float64
+		want       float64
+	}{
+		{desc: "Constant: Should never change", schedule: ConstantSchedule{0.1}, generation: 50, diversity: 0, want: 0.1},
+		{desc: "Linear: Should start at the start", schedule: LinearDecaySchedule{0.2, 0.02, 100}, generation: 0, want: 0.2},
+		{desc: "Linear: Should be halfway at half time", schedule: LinearDecaySchedule{0.2, 0.02, 100}, generation: 50, want: 0.11},
+		{desc: "Linear: Should stay at the end", schedule: LinearDecaySchedule{0.2, 0.02, 100}, generation: 150, want: 0.02},
+		{desc: "Exponential: Should decay every generation", schedule: ExponentialDecaySchedule{0.2, 0.5, 0.01}, generation: 2, want: 0.05},
+		{desc: "Exponential: Should never go below the floor", schedule: ExponentialDecaySchedule{0.2, 0.5, 0.01}, generation: 10, want: 0.01},
+		{desc: "Boost: Should keep the rate of a diverse population", schedule: DiversityBoostSchedule{ConstantSchedule{0.1}, 0.2, 3}, diversity: 0.5, want: 0.1},
+		{desc: "Boost: Should raise the rate when diversity collapses", schedule: DiversityBoostSchedule{ConstantSchedule{0.1}, 0.2, 3}, diversity: 0.1, want: 0.3},
+		{desc: "Boost: Should never go above 1", schedule: DiversityBoostSchedule{ConstantSchedule{0.5}, 0.2, 3}, diversity: 0, want: 1},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			got := tC.schedule.Rate(tC.generation, tC.diversity)
+
+			assert.InDelta(t, tC.want, got, 1e-9)
+		})
+	}
+}
+
+func TestEffectiveMutationRate(t *testing.T) {
+	sequencing := [][]byte{[]byte("AB")}
+	same := []*Candidate{
+		{NewChromosome([]byte("A"), sequencing), 1},
+		{NewChromosome([]byte("A"), sequencing), 1},
+	}
+
+	t.Run("Should use the mutation rate without a schedule", func(t *testing.T) {
+		got := EffectiveMutationRate(same, GenerationOptions{MutationRate: 0.1})
+
+		assert.Equal(t, 0.1, got)
+	})
+
+	t.Run("Should follow the schedule, knowing the generation and diversity", func(t *testing.T) {
+		options := GenerationOptions{
+			MutationRate: 0.1,
+			Generation:   1,
+			Schedule:     DiversityBoostSchedule{ExponentialDecaySchedule{0.2, 0.5, 0}, 0.5, 2},
+		}
+
+		got := EffectiveMutationRate(same, options)
+
+		assert.Equal(t, 0.2, got)
+	})
+}
+
+func TestSelfAdaptiveMutation(t *testing.T) {
+	bases := []byte("ABC")
+	sequencing := [][]byte{bases, bases, RateGeneBases}
+
+	t.Run("Should carry the rate in its rate gene", func(t *testing.T) {
+		chromosome := NewChromosome([]byte("AA-"), sequencing).WithMutationRate(2, MinSelfAdaptiveRate*selfAdaptiveRateStep*selfAdaptiveRateStep)
+
+		assert.Equal(t, []byte("AA2"), chromosome.Raw())
+		assert.InDelta(t, 0.00225, chromosome.MutationRate(), 1e-9)
+	})
+
+	t.Run("Should inherit the rate gene through crossover, nudge it and mutate with it", func(t *testing.T) {
+		mother := NewChromosome([]byte("AA-"), sequencing).WithMutationRate(2, rateOfGene('3'))
+		father := NewChromosome([]byte("BB-"), sequencing).WithMutationRate(2, rateOfGene('5'))
+
+		randomizerMock := &RandomizerMock{}
+		// every gene from the mother but the rate gene
+		randomizerMock.On("EventDidHappen", 0.5).Return(true).Times(2)
+		randomizerMock.On("EventDidHappen", 0.5).Return(false).Once()
+		// the father's rate, nudged up
+		randomizerMock.On("EventDidHappen", 0.5).Return(true).Once()
+		// mutate every gene but the rate with the child's own rate
+		randomizerMock.On("EventDidHappen", rateOfGene('6')).Return(false).Times(2)
+
+		child := mother.Merge(father, 0.01, randomizerMock)
+
+		assert.Equal(t, []byte("AA6"), child.Raw())
+		assert.Equal(t, rateOfGene('6'), child.MutationRate())
+		randomizerMock.AssertExpectations(t)
+	})
+
+	t.Run("Should keep the rate within bounds", func(t *testing.T) {
+		mother := NewChromosome([]byte("AA-"), sequencing).WithMutationRate(2, MinSelfAdaptiveRate)
+
+		randomizerMock := &RandomizerMock{}
+		randomizerMock.On("EventDidHappen", 0.5).Return(true).Times(3)
+		randomizerMock.On("EventDidHappen", 0.5).Return(false)
+		randomizerMock.On("EventDidHappen", MinSelfAdaptiveRate).Return(false)
+
+		child := mother.Merge(NewChromosome([]byte("BB-"), sequencing), 0.01, randomizerMock)
+
+		assert.Equal(t, MinSelfAdaptiveRate, child.MutationRate())
+	})
+
+	t.Run("Should take the receiver's rate when the rate gene inherited carries none", func(t *testing.T) {
+		mother := NewChromosome([]byte("AA-"), sequencing).WithMutationRate(2, rateOfGene('4'))
+
+		randomizerMock := &RandomizerMock{}
+		randomizerMock.On("EventDidHappen", 0.5).Return(false)
+		randomizerMock.On("EventDidHappen", rateOfGene('3')).Return(false)
+
+		child := mother.Merge(NewChromosome([]byte("BB-"), sequencing), 0.01, randomizerMock)
+
+		assert.Equal(t, []byte("BB3"), child.Raw())
+	})
+
+	t.Run("Should give random newcomers the effective rate", func(t *testing.T) {
+		randomizerMock := &RandomizerMock{}
+		randomizerMock.On("PickOne", bases).Return(bases[0])
+		randomizerMock.On("PickOne", RateGeneBases).Return(RateGeneBases[0])
+
+		options := GenerationOptions{PopulationSize: 2, MutationRate: rateOfGene('9'), SelfAdaptive: true, RateGene: 2}
+		got := NewGenerationFromPrevious([]*Candidate{}, sequencing, options, randomizerMock)
+
+		assert.Equal(t, rateOfGene('9'), AverageMutationRate(got))
+	})
+
+	t.Run("Should let seeds keep the rate they were saved with", func(t *testing.T) {
+		randomizerMock := &RandomizerMock{}
+
+		options := GenerationOptions{PopulationSize: 1, MutationRate: 0.1, SelfAdaptive: true, RateGene: 2, Seeds: [][]byte{[]byte("AB2")}, SeedRate: 1}
+		got := NewGenerationFromPrevious([]*Candidate{}, sequencing, options, randomizerMock)
+
+		assert.Equal(t, rateOfGene('2'), got[0].Chromosome.MutationRate())
+	})
+
+	t.Run("Should average no rate when none is carried", func(t *testing.T) {
+		got := AverageMutationRate([]*Candidate{{NewChromosome([]byte("AA4"), sequencing), 1}})
+
+		assert.Equal(t, 0.0, got)
+	})
+}
@@ -0,0 +1,142 @@
+package genetics
+
+import (
+	"math"
+	"sort"
+
+	"github.com/samber/lo"
+)
+
+type Selectorish interface {
+	// Picks count candidates, the same candidate may be picked more than once
+	Select(candidates []*Candidate, count int, randomizer Randomizerish) []*Candidate
+}
+
+// The fittest of Size candidates picked at random, two when unset
+type TournamentSelector struct {
+	Size int
+}
+
+// Candidates picked with a probability proportional to their fitness
+type RouletteSelector struct{}
+
+// Candidates picked by rank, the fittest Pressure times as likely as the average one; 1.5 when unset, from 1 to 2
+type RankSelector struct {
+	Pressure float64
+}
+
+// Like the roulette, with evenly spaced pointers from a single spin so picks follow fitness closely
+type StochasticUniversalSelector struct{}
+
+// Public methods
+
+func (selector TournamentSelector) Select(candidates []*Candidate, count int, randomizer Randomizerish) []*Candidate {
+	size := lo.Ternary(selector.Size > 0, selector.Size, 2)
+	selected := []*Candidate{}
+
+	for i := 0; i < count; i++ {
+		winner := candidates[randomizer.NumberBetween(0, len(candidates))]
+		for j := 1; j < size; j++ {
+			contender := candidates[randomizer.NumberBetween(0, len(candidates))]
+			if contender.Fitness > winner.Fitness {
+				winner = contender
+			}
+		}
+		selected = append(selected, winner)
+	}
+
+	return selected
+}
+
+func (selector RouletteSelector) Select(candidates []*Candidate, count int, randomizer Randomizerish) []*Candidate {
+	weights := fitnessWeights(candidates)
+	selected := []*Candidate{}
+
+	for i := 0; i < count; i++ {
+		selected = append(selected, candidates[spinWheel(weights, randomizer)])
+	}
+
+	return selected
+}
+
+func (selector RankSelector) Select(candidates []*Candidate, count int, randomizer Randomizerish) []*Candidate {
+	pressure := lo.Ternary(selector.Pressure > 0, selector.Pressure, 1.5)
+
+	ranked := append([]*Candidate{}, candidates...)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].Fitness < ranked[j].Fitness
+	})
+
+	// the worst candidate gets 2 - pressure, the best pressure, the average 1
+	weights := make([]float64, len(ranked))
+	for rank := range ranked {
+		weights[rank] = 2 - pressure
+		if len(ranked) > 1 {
+			weights[rank] += 2 * (pressure - 1) * float64(rank) / float64(len(ranked)-1)
+		}
+	}
+
+	selected := []*Candidate{}
+	for i := 0; i < count; i++ {
+		selected = append(selected, ranked[spinWheel(weights, randomizer)])
+	}
+
+	return selected
+}
+
+func (selector StochasticUniversalSelector) Select(candidates []*Candidate, count int, randomizer Randomizerish) []*Candidate {
+	selected := []*Candidate{}
+	if count <= 0 {
+		return selected
+	}
+
+	weights := fitnessWeights(candidates)
+	total := lo.Sum(weights)
+	if total <= 0 {
+		return RouletteSelector{}.Select(candidates, count, randomizer)
+	}
+
+	step := total / float64(count)
+	pointer := randomizer.Float() * step
+	cumulative := weights[0]
+	index := 0
+
+	for i := 0; i < count; i++ {
+		for pointer >= cumulative && index < len(weights)-1 {
+			index++
+			cumulative += weights[index]
+		}
+		selected = append(selected, candidates[index])
+		pointer += step
+	}
+
+	return selected
+}
+
+// Helper methods
+
+// Fitness as a weight, candidates with negative fitness are never picked
+func fitnessWeights(candidates []*Candidate) []float64 {
+	return lo.Map(candidates, func(candidate *Candidate, _ int) float64 {
+		return math.Max(candidate.Fitness, 0)
+	})
+}
+
+// The index a spin of a wheel with slices as wide as the weights lands on; uniform when every weight is zero
+func spinWheel(weights []float64, randomizer Randomizerish) int {
+	total := lo.Sum(weights)
+	if total <= 0 {
+		return randomizer.NumberBetween(0, len(weights))
+	}
+
+	spin := randomizer.Float() * total
+	cumulative := 0.0
+	for index, weight := range weights {
+		cumulative += weight
+		if spin < cumulative {
+			return index
+		}
+	}
+
+	return len(weights) - 1
+}
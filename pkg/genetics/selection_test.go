@@ -0,0 +1,126 @@
+package genetics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Helpers
+
+func getSelectionCandidates() (*Candidate, *Candidate, *Candidate) {
+	sequencing := [][]byte{[]byte("ABC")}
+
+	return &Candidate{NewChromosome([]byte("A"), sequencing), 0.1},
+		&Candidate{NewChromosome([]byte("B"), sequencing), 0.3},
+		&Candidate{NewChromosome([]byte("C"), sequencing), 0.6}
+}
+
+// Tests
+
+func TestTournamentSelector(t *testing.T) {
+	a, b, c := getSelectionCandidates()
+	candidates := []*Candidate{a, b, c}
+
+	t.Run("Should pick the fittest contender of each tournament", func(t *testing.T) {
+		randomizerMock := &RandomizerMock{}
+		randomizerMock.On("NumberBetween", 0, 3).Return(0).Once()
+		randomizerMock.On("NumberBetween", 0, 3).Return(2).Once()
+		randomizerMock.On("NumberBetween", 0, 3).Return(1).Once()
+		randomizerMock.On("NumberBetween", 0, 3).Return(0).Once()
+		randomizerMock.On("NumberBetween", 0, 3).Return(1).Once()
+		randomizerMock.On("NumberBetween", 0, 3).Return(0).Once()
+
+		got := TournamentSelector{Size: 3}.Select(candidates, 2, randomizerMock)
+
+		assert.Equal(t, []*Candidate{c, b}, got)
+		randomizerMock.AssertExpectations(t)
+	})
+
+	t.Run("Should hold tournaments of two by default", func(t *testing.T) {
+		randomizerMock := &RandomizerMock{}
+		randomizerMock.On("NumberBetween", 0, 3).Return(0).Once()
+		randomizerMock.On("NumberBetween", 0, 3).Return(1).Once()
+
+		got := TournamentSelector{}.Select(candidates, 1, randomizerMock)
+
+		assert.Equal(t, []*Candidate{b}, got)
+		randomizerMock.AssertExpectations(t)
+	})
+}
+
+func TestRouletteSelector(t *testing.T) {
+	a, b, c := getSelectionCandidates()
+	candidates := []*Candidate{a, b, c}
+
+	t.Run("Should pick candidates by their share of the total fitness", func(t *testing.T) {
+		randomizerMock := &RandomizerMock{}
+		randomizerMock.On("Float").Return(0.05).Once()
+		randomizerMock.On("Float").Return(0.35).Once()
+		randomizerMock.On("Float").Return(0.99).Once()
+
+		got := RouletteSelector{}.Select(candidates, 3, randomizerMock)
+
+		assert.Equal(t, []*Candidate{a, b, c}, got)
+		randomizerMock.AssertExpectations(t)
+	})
+
+	t.Run("Should pick uniformly when no candidate is fit", func(t *testing.T) {
+		unfit := []*Candidate{{a.Chromosome, 0}, {b.Chromosome, -1}}
+		randomizerMock := &RandomizerMock{}
+		randomizerMock.On("NumberBetween", 0, 2).Return(1)
+
+		got := RouletteSelector{}.Select(unfit, 1, randomizerMock)
+
+		assert.Equal(t, []*Candidate{unfit[1]}, got)
+	})
+}
+
+func TestRankSelector(t *testing.T) {
+	a, b, c := getSelectionCandidates()
+	// out of order, ranks don't depend on it
+	candidates := []*Candidate{c, a, b}
+
+	t.Run("Should pick candidates by their rank", func(t *testing.T) {
+		randomizerMock := &RandomizerMock{}
+		// weights are 0.5, 1 and 1.5, out of 3
+		randomizerMock.On("Float").Return(0.1).Once()
+		randomizerMock.On("Float").Return(0.3).Once()
+		randomizerMock.On("Float").Return(0.9).Once()
+
+		got := RankSelector{}.Select(candidates, 3, randomizerMock)
+
+		assert.Equal(t, []*Candidate{a, b, c}, got)
+		randomizerMock.AssertExpectations(t)
+	})
+
+	t.Run("Should never pick the worst candidate at full pressure", func(t *testing.T) {
+		randomizerMock := &RandomizerMock{}
+		randomizerMock.On("Float").Return(0.0)
+
+		got := RankSelector{Pressure: 2}.Select(candidates, 1, randomizerMock)
+
+		assert.Equal(t, []*Candidate{b}, got)
+	})
+}
+
+func TestStochasticUniversalSelector(t *testing.T) {
+	a, b, c := getSelectionCandidates()
+	candidates := []*Candidate{a, b, c}
+
+	t.Run("Should pick with evenly spaced pointers from a single spin", func(t *testing.T) {
+		randomizerMock := &RandomizerMock{}
+		randomizerMock.On("Float").Return(0.5)
+
+		got := StochasticUniversalSelector{}.Select(candidates, 4, randomizerMock)
+
+		assert.Equal(t, []*Candidate{b, b, c, c}, got)
+		randomizerMock.AssertNumberOfCalls(t, "Float", 1)
+	})
+
+	t.Run("Should pick nothing when asked for nothing", func(t *testing.T) {
+		got := StochasticUniversalSelector{}.Select(candidates, 0, &RandomizerMock{})
+
+		assert.Empty(t, got)
+	})
+}